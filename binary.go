@@ -0,0 +1,132 @@
+package daytime
+
+import (
+	"encoding/binary"
+)
+
+// Binary format:
+//
+// A single daytime is encoded in 3 bytes as a big-endian 24-bit word,
+// where the high 7 bits hold the format version and the low 17 bits hold the seconds:
+//
+//	version<<17 | seconds
+//
+// A packed slice starts with a version byte and the uvarint element count,
+// followed by each element as a zigzag varint delta from the previous one
+// (the first delta is relative to StartOfDay).
+const (
+	binaryVersion = 1
+	binarySize    = 3
+	binaryBits    = 17
+	binaryMask    = 1<<binaryBits - 1
+
+	packedVersion = 1
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// Returns ErrValueOutOfRange for invalid daytimes.
+func (d Daytime) MarshalBinary() ([]byte, error) {
+	return d.AppendBinary(make([]byte, 0, binarySize))
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+//
+// It appends the same encoding as MarshalBinary to b.
+func (d Daytime) AppendBinary(b []byte) ([]byte, error) {
+	if !d.Valid() {
+		return b, errorf("AppendBinary", uint32(d), ErrValueOutOfRange)
+	}
+	word := uint32(binaryVersion)<<binaryBits | uint32(d)
+	return append(b, byte(word>>16), byte(word>>8), byte(word)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+//
+// Returns ErrInvalidFormat if the data has the wrong length or version,
+// and ErrValueOutOfRange if the decoded daytime is not valid.
+func (d *Daytime) UnmarshalBinary(data []byte) error {
+	if len(data) != binarySize {
+		return errorf("UnmarshalBinary", len(data), ErrInvalidFormat)
+	}
+	word := uint32(data[0])<<16 | uint32(data[1])<<8 | uint32(data[2])
+	if version := word >> binaryBits; version != binaryVersion {
+		return errorf("UnmarshalBinary", version, ErrInvalidFormat)
+	}
+
+	decoded := Daytime(word & binaryMask)
+	if !decoded.Valid() {
+		return errorf("UnmarshalBinary", uint32(decoded), ErrValueOutOfRange)
+	}
+	*d = decoded
+	return nil
+}
+
+// AppendPacked appends a compact encoding of the daytimes to b.
+//
+// Consecutive daytimes are stored as varint deltas, so sorted or clustered
+// slices typically take one or two bytes per element.
+// Returns b unchanged and ErrValueOutOfRange if any daytime is invalid.
+func AppendPacked(b []byte, ds []Daytime) ([]byte, error) {
+	for _, d := range ds {
+		if !d.Valid() {
+			return b, errorf("AppendPacked", uint32(d), ErrValueOutOfRange)
+		}
+	}
+
+	b = append(b, packedVersion)
+	b = binary.AppendUvarint(b, uint64(len(ds)))
+
+	prev := StartOfDay
+	for _, d := range ds {
+		b = binary.AppendVarint(b, int64(d)-int64(prev))
+		prev = d
+	}
+	return b, nil
+}
+
+// MarshalPacked returns a compact encoding of the daytimes.
+//
+// See AppendPacked for details.
+func MarshalPacked(ds []Daytime) ([]byte, error) {
+	return AppendPacked(nil, ds)
+}
+
+// UnmarshalPacked decodes daytimes encoded by MarshalPacked or AppendPacked.
+//
+// Returns ErrInvalidFormat for truncated data or an unknown version,
+// and ErrValueOutOfRange if any decoded daytime is not valid.
+func UnmarshalPacked(data []byte) ([]Daytime, error) {
+	if len(data) == 0 || data[0] != packedVersion {
+		return nil, errorf("UnmarshalPacked", nil, ErrInvalidFormat)
+	}
+	data = data[1:]
+
+	count, n := binary.Uvarint(data)
+	// Every element takes at least one byte, which bounds the allocation for corrupt counts.
+	if n <= 0 || count > uint64(len(data)-n) {
+		return nil, errorf("UnmarshalPacked", nil, ErrInvalidFormat)
+	}
+	data = data[n:]
+
+	ds := make([]Daytime, 0, count)
+	prev := int64(StartOfDay)
+	for range count {
+		delta, n := binary.Varint(data)
+		if n <= 0 {
+			return nil, errorf("UnmarshalPacked", nil, ErrInvalidFormat)
+		}
+		data = data[n:]
+
+		value := prev + delta
+		if value < 0 || value > binaryMask || !Daytime(value).Valid() {
+			return nil, errorf("UnmarshalPacked", value, ErrValueOutOfRange)
+		}
+		ds = append(ds, Daytime(value))
+		prev = value
+	}
+	if len(data) != 0 {
+		return nil, errorf("UnmarshalPacked", len(data), ErrInvalidFormat)
+	}
+	return ds, nil
+}
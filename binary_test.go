@@ -0,0 +1,188 @@
+package daytime
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"slices"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Daytime(0)
+	_ encoding.BinaryUnmarshaler = (*Daytime)(nil)
+	_ encoding.BinaryAppender    = Daytime(0)
+	_ encoding.TextAppender      = Daytime(0)
+)
+
+func TestDaytime_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Daytime
+		want []byte
+		err  error
+	}{
+		{"StartOfDay", D000000, []byte{0x02, 0x00, 0x00}, nil},
+		{"Mid-day", D120000, []byte{0x02, 0xa8, 0xc0}, nil},
+		{"EndOfDay", D240000, []byte{0x03, 0x51, 0x80}, nil},
+		{"Invalid daytime", DInvalid, nil, ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.MarshalBinary()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("MarshalBinary() got error %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("MarshalBinary() got %x, want %x", got, tt.want)
+			}
+
+			var decoded Daytime
+			if err := decoded.UnmarshalBinary(got); err != nil || decoded != tt.d {
+				t.Errorf("UnmarshalBinary(%x) got (%s, %v), want %s", got, decoded, err, tt.d)
+			}
+		})
+	}
+}
+
+func TestDaytime_UnmarshalBinary_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"Empty", nil, ErrInvalidFormat},
+		{"Too long", []byte{0x02, 0x00, 0x00, 0x00}, ErrInvalidFormat},
+		{"Unknown version", []byte{0x04, 0x00, 0x00}, ErrInvalidFormat},
+		{"Value beyond EndOfDay", []byte{0x03, 0x51, 0x81}, ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := D120000
+			if err := d.UnmarshalBinary(tt.data); !errors.Is(err, tt.err) {
+				t.Errorf("UnmarshalBinary(%x) got error %v, want %v", tt.data, err, tt.err)
+			}
+			if d != D120000 {
+				t.Errorf("UnmarshalBinary(%x) modified daytime on error: got %s", tt.data, d)
+			}
+		})
+	}
+}
+
+func TestDaytime_Appenders(t *testing.T) {
+	prefix := []byte("x")
+
+	got, err := D123045.AppendText(prefix)
+	if err != nil || string(got) != "x12:30:45" {
+		t.Errorf("AppendText() got (%q, %v), want x12:30:45", got, err)
+	}
+
+	got, err = D240000.AppendBinary(prefix)
+	if err != nil || !bytes.Equal(got, []byte{'x', 0x03, 0x51, 0x80}) {
+		t.Errorf("AppendBinary() got (%x, %v), want 78035180", got, err)
+	}
+
+	if _, err := DInvalid.AppendText(nil); !errors.Is(err, ErrValueOutOfRange) {
+		t.Errorf("AppendText() for invalid daytime got error %v, want %v", err, ErrValueOutOfRange)
+	}
+}
+
+func TestDaytime_Gob(t *testing.T) {
+	type event struct {
+		Name string
+		At   Daytime
+	}
+	in := []event{{"open", Must(9, 0, 0)}, {"close", D240000}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("gob Encode() got unexpected error: %v", err)
+	}
+	var out []event
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("gob Decode() got unexpected error: %v", err)
+	}
+	if !slices.Equal(in, out) {
+		t.Errorf("gob round trip got %v, want %v", out, in)
+	}
+}
+
+func TestPacked(t *testing.T) {
+	tests := []struct {
+		name string
+		ds   []Daytime
+		size int
+	}{
+		{"Empty", nil, 2},
+		{"Single", []Daytime{D240000}, 5},
+		{"Sorted grid", []Daytime{Must(8, 0, 0), Must(8, 15, 0), Must(8, 30, 0), Must(8, 45, 0)}, 2 + 3 + 3*2},
+		{"Unsorted", []Daytime{D230000, D010000, D240000, D000000}, 2 + 3 + 3 + 3 + 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := MarshalPacked(tt.ds)
+			if err != nil {
+				t.Fatalf("MarshalPacked() got unexpected error: %v", err)
+			}
+			if len(data) != tt.size {
+				t.Errorf("MarshalPacked() got %d bytes, want %d", len(data), tt.size)
+			}
+
+			got, err := UnmarshalPacked(data)
+			if err != nil {
+				t.Fatalf("UnmarshalPacked(%x) got unexpected error: %v", data, err)
+			}
+			if !slices.Equal(got, tt.ds) && !(len(got) == 0 && len(tt.ds) == 0) {
+				t.Errorf("UnmarshalPacked(%x) got %v, want %v", data, got, tt.ds)
+			}
+		})
+	}
+
+	t.Run("Invalid input daytime", func(t *testing.T) {
+		if _, err := MarshalPacked([]Daytime{D120000, DInvalid}); !errors.Is(err, ErrValueOutOfRange) {
+			t.Errorf("MarshalPacked() got error %v, want %v", err, ErrValueOutOfRange)
+		}
+
+		prefix := make([]byte, 2, 16)
+		prefix[0], prefix[1] = 0xAA, 0xBB
+		got, err := AppendPacked(prefix, []Daytime{D120000, DInvalid})
+		if !errors.Is(err, ErrValueOutOfRange) {
+			t.Errorf("AppendPacked() got error %v, want %v", err, ErrValueOutOfRange)
+		}
+		if !bytes.Equal(got, []byte{0xAA, 0xBB}) || !bytes.Equal(prefix[:cap(prefix)][2:], make([]byte, 14)) {
+			t.Errorf("AppendPacked() on error got %x with spare capacity %x, want aabb and untouched capacity", got, prefix[2:cap(prefix)])
+		}
+	})
+}
+
+func TestUnmarshalPacked_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"Empty", nil, ErrInvalidFormat},
+		{"Unknown version", []byte{0x02, 0x00}, ErrInvalidFormat},
+		{"Missing count", []byte{0x01}, ErrInvalidFormat},
+		{"Count exceeds data", []byte{0x01, 0x05, 0x02}, ErrInvalidFormat},
+		{"Truncated varint", []byte{0x01, 0x01, 0x80}, ErrInvalidFormat},
+		{"Trailing bytes", []byte{0x01, 0x01, 0x02, 0x02}, ErrInvalidFormat},
+		{"Negative daytime", []byte{0x01, 0x01, 0x01}, ErrValueOutOfRange},
+		{"Beyond EndOfDay", []byte{0x01, 0x01, 0x82, 0xc6, 0x0a}, ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := UnmarshalPacked(tt.data); !errors.Is(err, tt.err) {
+				t.Errorf("UnmarshalPacked(%x) got error %v, want %v", tt.data, err, tt.err)
+			}
+		})
+	}
+}
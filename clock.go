@@ -0,0 +1,267 @@
+package daytime
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time and timers, so that time-of-day logic can be driven
+// by something other than the system clock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer creates a timer that sends the current time on its channel after at least d.
+	NewTimer(d time.Duration) Timer
+
+	// AfterFunc waits for at least d and then calls f in its own goroutine.
+	// The returned timer has a nil channel.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a single event created by a Clock, with the semantics of time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered, or nil for AfterFunc timers.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing.
+	// Returns true if the call stops the timer, false if it already expired or was stopped.
+	Stop() bool
+
+	// Reset changes the timer to expire after d.
+	// Returns true if the timer had been active.
+	Reset(d time.Duration) bool
+}
+
+// SystemClock is the Clock backed by the time package.
+var SystemClock Clock = systemClock{}
+
+// Now returns the current daytime of the clock, in the location of the time it reports.
+// A nil clock means SystemClock.
+func Now(clock Clock) Daytime {
+	if clock == nil {
+		clock = SystemClock
+	}
+	return FromTime(clock.Now())
+}
+
+// NowIn returns the current daytime of the clock in loc.
+// A nil clock means SystemClock; a nil loc means time.Local.
+func NowIn(clock Clock, loc *time.Location) Daytime {
+	if clock == nil {
+		clock = SystemClock
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+	return FromTime(clock.Now().In(loc))
+}
+
+// PreciseNow returns the current precise daytime of the clock, in the location of the time it reports.
+// A nil clock means SystemClock.
+func PreciseNow(clock Clock) Precise {
+	if clock == nil {
+		clock = SystemClock
+	}
+	return PreciseFromTime(clock.Now())
+}
+
+// systemClock implements Clock with the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return systemTimer{time.AfterFunc(d, f)}
+}
+
+// systemTimer adapts *time.Timer to Timer.
+type systemTimer struct {
+	timer *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+func (t systemTimer) Reset(d time.Duration) bool {
+	return t.timer.Reset(d)
+}
+
+// FakeClock is a Clock that only moves when told to, for deterministic tests.
+//
+// Its timers fire while the clock is moved forward, in the order of their expiry, and the
+// clock reads their expiry time while they fire. Functions of AfterFunc timers are called
+// synchronously, before Advance or Set returns, so they may use the clock themselves.
+// Channel timers have a buffer of one and drop the time if it is full.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// NewFakeClock creates a fake clock reading the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current time of the fake clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer creates a timer that sends the time on its channel once the clock reaches now+d.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	return c.add(d, make(chan time.Time, 1), nil)
+}
+
+// AfterFunc creates a timer that calls f once the clock reaches now+d.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	return c.add(d, nil, f)
+}
+
+// Advance moves the clock forward by d, firing all timers that expire on the way.
+// Negative durations are ignored.
+func (c *FakeClock) Advance(d time.Duration) {
+	if d < 0 {
+		return
+	}
+	c.Set(c.Now().Add(d))
+}
+
+// AdvanceTo moves the clock forward to the next instant at which its wall clock
+// shows the daytime, in the location of the current time, and returns that instant.
+//
+// It follows the rules of Daytime.Next, so days on which the daytime falls into a
+// daylight saving gap are skipped. Returns the zero time for invalid daytimes.
+func (c *FakeClock) AdvanceTo(d Daytime) time.Time {
+	next := d.Next(c.Now())
+	if !next.IsZero() {
+		c.Set(next)
+	}
+	return next
+}
+
+// Set moves the clock to t, firing all timers that expire up to t.
+//
+// Moving the clock backward fires nothing, and timers keep their expiry time.
+// The clock keeps the location of t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	for {
+		timer := c.due(t)
+		if timer == nil {
+			break
+		}
+		timer.active = false
+		c.now = timer.when.In(t.Location())
+		c.mu.Unlock()
+		timer.fire(c.now)
+		c.mu.Lock()
+	}
+	c.now = t
+	c.mu.Unlock()
+}
+
+// Pending returns the number of active timers.
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := 0
+	for _, timer := range c.timers {
+		if timer.active {
+			n++
+		}
+	}
+	return n
+}
+
+// add creates an active timer expiring after d.
+func (c *FakeClock) add(d time.Duration, ch chan time.Time, f func()) *fakeTimer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := &fakeTimer{clock: c, when: c.now.Add(d), c: ch, f: f, active: true}
+	c.timers = append(c.timers, timer)
+	return timer
+}
+
+// due returns the active timer expiring first, if it expires up to t,
+// and drops inactive timers. The caller must hold c.mu.
+func (c *FakeClock) due(t time.Time) *fakeTimer {
+	var first *fakeTimer
+	active := c.timers[:0]
+	for _, timer := range c.timers {
+		if !timer.active {
+			continue
+		}
+		active = append(active, timer)
+		if !timer.when.After(t) && (first == nil || timer.when.Before(first.when)) {
+			first = timer
+		}
+	}
+	clear(c.timers[len(active):])
+	c.timers = active
+	return first
+}
+
+// fakeTimer is a timer of a FakeClock.
+type fakeTimer struct {
+	clock  *FakeClock
+	when   time.Time
+	c      chan time.Time
+	f      func()
+	active bool
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	wasActive := t.active
+	t.active = false
+	return wasActive
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	wasActive := t.active
+	t.when, t.active = t.clock.now.Add(d), true
+	// A stopped or expired timer may have been dropped from the clock.
+	if !slices.Contains(t.clock.timers, t) {
+		t.clock.timers = append(t.clock.timers, t)
+	}
+	return wasActive
+}
+
+// fire delivers the expiry of the timer.
+func (t *fakeTimer) fire(now time.Time) {
+	if t.f != nil {
+		t.f()
+		return
+	}
+	select {
+	case t.c <- now:
+	default:
+	}
+}
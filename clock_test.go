@@ -0,0 +1,160 @@
+package daytime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNow(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	clock := NewFakeClock(time.Date(2025, time.June, 1, 12, 30, 45, 500, berlin))
+
+	if got := Now(clock); got != D123045 {
+		t.Errorf("Now() got %s, want %s", got, D123045)
+	}
+	if got, want := NowIn(clock, time.UTC), Must(10, 30, 45); got != want {
+		t.Errorf("NowIn() got %s, want %s", got, want)
+	}
+	if got, want := PreciseNow(clock), MustPrecise(12, 30, 45, 500); got != want {
+		t.Errorf("PreciseNow() got %s, want %s", got, want)
+	}
+	if got := Now(nil); !got.Valid() || got == EndOfDay {
+		t.Errorf("Now(nil) got %s, want a valid daytime", got)
+	}
+}
+
+func TestFakeClock_Advance(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name    string
+		start   time.Time
+		advance time.Duration
+		want    Daytime
+	}{
+		{"Within day", time.Date(2025, time.June, 1, 12, 0, 0, 0, berlin), 30*time.Minute + 45*time.Second, Must(12, 30, 45)},
+		{"Across midnight", time.Date(2025, time.June, 1, 23, 0, 0, 0, berlin), 2 * time.Hour, D010000},
+		{"Across spring forward", time.Date(2025, time.March, 30, 1, 30, 0, 0, berlin), time.Hour, Must(3, 30, 0)},
+		{"Across fall back", time.Date(2025, time.October, 26, 0, 30, 0, 0, time.UTC).In(berlin), time.Hour, Must(2, 30, 0)},
+		{"Negative is ignored", time.Date(2025, time.June, 1, 12, 0, 0, 0, berlin), -time.Hour, D120000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := NewFakeClock(tt.start)
+			clock.Advance(tt.advance)
+			if got := Now(clock); got != tt.want {
+				t.Errorf("Now() after Advance(%v) got %s, want %s", tt.advance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFakeClock_AdvanceTo(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	clock := NewFakeClock(time.Date(2025, time.March, 29, 12, 0, 0, 0, berlin))
+
+	got := clock.AdvanceTo(Must(2, 30, 0))
+	want := time.Date(2025, time.March, 31, 2, 30, 0, 0, berlin)
+	if !got.Equal(want) || !clock.Now().Equal(want) {
+		t.Errorf("AdvanceTo() got %v and clock %v, want %v", got, clock.Now(), want)
+	}
+
+	if got := clock.AdvanceTo(DInvalid); !got.IsZero() || !clock.Now().Equal(want) {
+		t.Errorf("AdvanceTo(invalid) got %v and clock %v, want zero time and unchanged clock", got, clock.Now())
+	}
+}
+
+func TestFakeClock_Timers(t *testing.T) {
+	start := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	var order []string
+	var seen []time.Time
+	clock.AfterFunc(2*time.Hour, func() {
+		order = append(order, "second")
+		seen = append(seen, clock.Now())
+	})
+	clock.AfterFunc(time.Hour, func() {
+		order = append(order, "first")
+		seen = append(seen, clock.Now())
+		// Timers created while firing fire within the same Advance.
+		clock.AfterFunc(30*time.Minute, func() { order = append(order, "nested") })
+	})
+	stopped := clock.AfterFunc(90*time.Minute, func() { order = append(order, "stopped") })
+	timer := clock.NewTimer(3 * time.Hour)
+
+	if clock.Pending() != 4 {
+		t.Errorf("Pending() got %d, want 4", clock.Pending())
+	}
+	if !stopped.Stop() {
+		t.Error("Stop() on active timer got false, want true")
+	}
+	if stopped.Stop() {
+		t.Error("Stop() on stopped timer got true, want false")
+	}
+
+	clock.Advance(150 * time.Minute)
+	if want := []string{"first", "nested", "second"}; len(order) != len(want) || order[0] != want[0] || order[1] != want[1] || order[2] != want[2] {
+		t.Errorf("timers fired in order %v, want %v", order, want)
+	}
+	if !seen[0].Equal(start.Add(time.Hour)) || !seen[1].Equal(start.Add(2*time.Hour)) {
+		t.Errorf("clock read %v while firing, want expiry times", seen)
+	}
+	expectNone(t, timer.C())
+
+	clock.Advance(30 * time.Minute)
+	if got := receive(t, timer.C()); !got.Equal(start.Add(3 * time.Hour)) {
+		t.Errorf("timer delivered %v, want %v", got, start.Add(3*time.Hour))
+	}
+	if clock.Pending() != 0 {
+		t.Errorf("Pending() got %d, want 0", clock.Pending())
+	}
+
+	if timer.Reset(time.Hour) {
+		t.Error("Reset() on expired timer got true, want false")
+	}
+	clock.Set(start.Add(4 * time.Hour))
+	if got := receive(t, timer.C()); !got.Equal(start.Add(4 * time.Hour)) {
+		t.Errorf("reset timer delivered %v, want %v", got, start.Add(4*time.Hour))
+	}
+
+	// Moving backward fires nothing.
+	clock.NewTimer(time.Hour)
+	clock.Set(start)
+	if clock.Pending() != 1 || !clock.Now().Equal(start) {
+		t.Errorf("after Set backward got %d pending at %v, want 1 at %v", clock.Pending(), clock.Now(), start)
+	}
+}
+
+func TestSystemClock(t *testing.T) {
+	before := time.Now()
+	now := SystemClock.Now()
+	if now.Before(before) {
+		t.Errorf("Now() got %v, want at least %v", now, before)
+	}
+
+	timer := SystemClock.NewTimer(time.Millisecond)
+	select {
+	case <-timer.C():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer did not fire")
+	}
+
+	done := make(chan struct{})
+	f := SystemClock.AfterFunc(time.Hour, func() { close(done) })
+	if f.C() != nil {
+		t.Error("AfterFunc timer has a channel, want nil")
+	}
+	if !f.Reset(time.Millisecond) {
+		t.Error("Reset() on active timer got false, want true")
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("function was not called")
+	}
+	if f.Stop() {
+		t.Error("Stop() after firing got true, want false")
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+// maxLineSize bounds the memory used for a single input line.
+// Longer lines are truncated to their first maxLineSize bytes.
+const maxLineSize = 1 << 20
+
+// stdin is the input of the filter command.
+var stdin io.Reader = os.Stdin
+
+// layouts maps the names of the time package layouts accepted by --layout to their values.
+var layouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"Stamp":       time.Stamp,
+	"StampMilli":  time.StampMilli,
+	"StampMicro":  time.StampMicro,
+	"StampNano":   time.StampNano,
+	"DateTime":    time.DateTime,
+	"TimeOnly":    time.TimeOnly,
+}
+
+// extractor finds the timestamp text in a line.
+type extractor func(line string) (string, bool)
+
+func runFilter(fs *flag.FlagSet, args []string, out *output) error {
+	windowText := fs.String("window", "", "range of daytimes to keep, including both ends unless bracketed\n"+
+		"as in [22:00:00-06:00:00), e.g. 22:00:00-06:00:00 (required)")
+	layoutName := fs.String("layout", "RFC3339", "timestamp layout: a time package constant name or a Go layout")
+	tzName := fs.String("tz", "", "time zone of the daytimes (default the zone of each timestamp, Local if it has none)")
+	pattern := fs.String("regex", "", "regular expression matching the timestamp; its first group is used if it has one")
+	field := fs.Int("field", 1, "1-based field holding the timestamp, spanning as many fields as the layout")
+	delimiter := fs.String("delimiter", "", "field delimiter (default runs of white space)")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	if *windowText == "" {
+		return fmt.Errorf("%w: --window is required", errUsage)
+	}
+	spec := *windowText
+	if !strings.ContainsAny(spec[:1], "[(") {
+		// Like Between, a window without brackets includes both ends.
+		spec = "[" + spec + "]"
+	}
+	window, err := daytime.ParseRange(spec)
+	if err != nil {
+		return fmt.Errorf("%w: --window: %v", errUsage, err)
+	}
+	layout, ok := layouts[*layoutName]
+	if !ok {
+		layout = *layoutName
+	}
+	loc := time.Local
+	if *tzName != "" {
+		if loc, err = time.LoadLocation(*tzName); err != nil {
+			return fmt.Errorf("%w: --tz: %v", errUsage, err)
+		}
+	}
+
+	var extract extractor
+	switch {
+	case *pattern != "":
+		re, err := regexp.Compile(*pattern)
+		if err != nil {
+			return fmt.Errorf("%w: --regex: %v", errUsage, err)
+		}
+		extract = regexExtractor(re)
+	case *field < 1:
+		return fmt.Errorf("%w: --field must be at least 1", errUsage)
+	default:
+		extract = fieldExtractor(*field, *delimiter, layout)
+	}
+
+	w := bufio.NewWriter(out.w)
+	matches := &output{w: w, json: out.json}
+	r := bufio.NewReaderSize(stdin, maxLineSize)
+	for {
+		line, err := readLine(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			w.Flush()
+			return fmt.Errorf("daytime filter: reading input: %w", err)
+		}
+
+		text, ok := extract(line)
+		if !ok {
+			continue
+		}
+		t, err := time.ParseInLocation(layout, text, loc)
+		if err != nil {
+			continue
+		}
+		if *tzName != "" {
+			t = t.In(loc)
+		}
+		d := daytime.FromTime(t)
+		if !window.Contains(d) {
+			continue
+		}
+		err = matches.print(line, struct {
+			Line    string          `json:"line"`
+			Time    string          `json:"time"`
+			Daytime daytime.Daytime `json:"daytime"`
+		}{line, t.Format(time.RFC3339Nano), d})
+		if err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// readLine returns the next line of r without its line ending.
+//
+// A line longer than the buffer of r is truncated to the buffer size and the rest
+// of it is discarded, so memory stays bounded. Returns io.EOF after the last line.
+func readLine(r *bufio.Reader) (string, error) {
+	slice, err := r.ReadSlice('\n')
+	line := string(slice)
+	for err == bufio.ErrBufferFull {
+		_, err = r.ReadSlice('\n')
+	}
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimSuffix(line, "\n")
+	return strings.TrimSuffix(line, "\r"), nil
+}
+
+// regexExtractor returns the first group of the first match of re, or the whole match without groups.
+func regexExtractor(re *regexp.Regexp) extractor {
+	group := min(re.NumSubexp(), 1)
+	return func(line string) (string, bool) {
+		m := re.FindStringSubmatchIndex(line)
+		if m == nil || m[2*group] < 0 {
+			return "", false
+		}
+		return line[m[2*group]:m[2*group+1]], true
+	}
+}
+
+// fieldExtractor returns the n-th field and, when splitting at white space,
+// the following fields up to the number of white-space separated parts of the layout.
+func fieldExtractor(n int, delimiter, layout string) extractor {
+	if delimiter != "" {
+		return func(line string) (string, bool) {
+			fields := strings.Split(line, delimiter)
+			if n > len(fields) {
+				return "", false
+			}
+			return strings.TrimSpace(fields[n-1]), true
+		}
+	}
+
+	width := max(len(strings.Fields(layout)), 1)
+	return func(line string) (string, bool) {
+		fields := strings.Fields(line)
+		if n-1+width > len(fields) {
+			return "", false
+		}
+		return strings.Join(fields[n-1:n-1+width], " "), true
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	_ "time/tzdata"
+)
+
+const testLog = `2025-06-01T21:59:59Z start
+2025-06-01T22:00:00Z nightly job begins
+2025-06-02T02:00:00+02:00 backup
+not a timestamp
+2025-06-02T05:59:59Z cleanup
+2025-06-02T06:00:00Z day shift
+2025-06-02T12:00:00+02:00 lunch
+`
+
+func TestRunFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		input  string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			"Window across midnight", []string{"--window", "22:00:00-06:00:00"}, testLog, exitOK,
+			"2025-06-01T22:00:00Z nightly job begins\n2025-06-02T02:00:00+02:00 backup\n2025-06-02T05:59:59Z cleanup\n2025-06-02T06:00:00Z day shift\n", "",
+		},
+		{
+			"Half-open window", []string{"--window", "[22:00:00-06:00:00)"}, testLog, exitOK,
+			"2025-06-01T22:00:00Z nightly job begins\n2025-06-02T02:00:00+02:00 backup\n2025-06-02T05:59:59Z cleanup\n", "",
+		},
+		{
+			"CRLF line endings", []string{"--window", "02:00:00-03:00:00", "--regex", `\S+$`},
+			"a 2025-06-02T02:00:00Z\r\nb 2025-06-02T04:00:00Z\r\n", exitOK, "a 2025-06-02T02:00:00Z\n", "",
+		},
+		{
+			"Time zone", []string{"--window", "02:00:00-03:00:00", "--tz", "Europe/Paris"}, testLog, exitOK,
+			"2025-06-02T02:00:00+02:00 backup\n", "",
+		},
+		{
+			"Closed window", []string{"--window", "[05:59:59-06:00:00]", "--tz", "UTC"}, testLog, exitOK,
+			"2025-06-02T05:59:59Z cleanup\n2025-06-02T06:00:00Z day shift\n", "",
+		},
+		{
+			"Field with delimiter", []string{"--window", "12:00:00-13:00:00", "--field", "2", "--delimiter", "|", "--layout", "15:04"},
+			"a|12:30|lunch\nb|13:30|meeting\nc\n", exitOK, "a|12:30|lunch\n", "",
+		},
+		{
+			"Layout spanning fields", []string{"--window", "02:00:00-02:01:00", "--field", "2", "--layout", "DateTime"},
+			"INFO 2025-06-02 02:00:30 backup\nINFO 2025-06-02 03:00:30 done\n", exitOK, "INFO 2025-06-02 02:00:30 backup\n", "",
+		},
+		{
+			"Syslog stamp", []string{"--window", "02:00:00-03:00:00", "--layout", "Stamp"},
+			"Jun  2 02:15:00 host cron[1]: run\nJun 12 14:15:00 host cron[1]: run\n", exitOK, "Jun  2 02:15:00 host cron[1]: run\n", "",
+		},
+		{
+			"Regex group", []string{"--window", "02:00:00-03:00:00", "--regex", `at=(\S+)`},
+			"level=info at=2025-06-02T02:30:00Z msg=a\nlevel=info at=2025-06-02T03:30:00Z msg=b\nlevel=info msg=c\n", exitOK,
+			"level=info at=2025-06-02T02:30:00Z msg=a\n", "",
+		},
+		{
+			"Regex match", []string{"--window", "02:00:00-03:00:00", "--regex", `\d\d:\d\d:\d\d`, "--layout", "TimeOnly"},
+			"[02:30:00] a\n[03:30:00] b\n", exitOK, "[02:30:00] a\n", "",
+		},
+		{
+			"JSON", []string{"--json", "--window", "02:00:00-03:00:00"}, testLog, exitOK,
+			`{"line":"2025-06-02T02:00:00+02:00 backup","time":"2025-06-02T02:00:00+02:00","daytime":"02:00:00"}` + "\n", "",
+		},
+		{"Missing window", nil, testLog, exitUsage, "", "--window is required"},
+		{"Invalid window", []string{"--window", "night"}, testLog, exitUsage, "", "--window"},
+		{"Invalid regex", []string{"--window", "02:00:00-03:00:00", "--regex", "("}, testLog, exitUsage, "", "--regex"},
+		{"Invalid field", []string{"--window", "02:00:00-03:00:00", "--field", "0"}, testLog, exitUsage, "", "--field"},
+		{"Unexpected argument", []string{"--window", "02:00:00-03:00:00", "app.log"}, testLog, exitUsage, "", "expected 0 arguments"},
+		{
+			"Line too long is truncated", []string{"--window", "02:00:00-03:00:00"},
+			"2025-06-02T02:00:00Z " + strings.Repeat("x", 2*maxLineSize) + "\n2025-06-02T04:00:00Z b\n2025-06-02T02:30:00Z c", exitOK,
+			"2025-06-02T02:00:00Z " + strings.Repeat("x", maxLineSize-len("2025-06-02T02:00:00Z ")) + "\n2025-06-02T02:30:00Z c\n", "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := stdin
+			defer func() { stdin = saved }()
+			stdin = strings.NewReader(tt.input)
+
+			var stdout, stderr bytes.Buffer
+			code := run(append([]string{"filter"}, tt.args...), &stdout, &stderr)
+			if code != tt.code {
+				t.Errorf("run() got exit code %d, want %d (stderr %q)", code, tt.code, stderr.String())
+			}
+			if stdout.String() != tt.stdout {
+				t.Errorf("run() got stdout %q, want %q", stdout.String(), tt.stdout)
+			}
+			if !strings.Contains(stderr.String(), tt.stderr) {
+				t.Errorf("run() got stderr %q, want it to contain %q", stderr.String(), tt.stderr)
+			}
+		})
+	}
+}
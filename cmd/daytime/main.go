@@ -0,0 +1,354 @@
+// Command daytime parses, formats and computes with times of day from the shell.
+//
+// Usage:
+//
+//	daytime <command> [flags] [arguments]
+//
+// Commands:
+//
+//	parse <text>                       parse a time of day such as "9:30pm"
+//	add <daytime> <duration>           add a duration, printing the result and day carry
+//	diff <daytime> <daytime>           subtract the second daytime from the first
+//	between <daytime> <start> <end>    report whether a daytime is within [start, end]
+//	convert --from Z --to Z <daytime>  convert a daytime between time zones
+//	steps <start> <end> <step>         list daytimes from start to end at a step
+//	filter --window R < log            keep lines whose timestamp falls in a range of daytimes
+//
+// The filter command streams standard input, reading each line's timestamp from a
+// field or a regular expression match:
+//
+//	daytime filter --window 22:00:00-06:00:00 --layout RFC3339 --tz Europe/Paris < app.log
+//
+// Every command accepts --json to print JSON instead of text.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+const usage = `usage: daytime <command> [flags] [arguments]
+
+commands:
+  parse <text>                       parse a time of day such as "9:30pm"
+  add <daytime> <duration>           add a duration, printing the result and day carry
+  diff <daytime> <daytime>           subtract the second daytime from the first
+  between <daytime> <start> <end>    report whether a daytime is within [start, end]
+  convert --from Z --to Z <daytime>  convert a daytime between time zones
+  steps <start> <end> <step>         list daytimes from start to end at a step
+  filter --window R < log            keep lines whose timestamp falls in a range of daytimes
+
+Every command accepts --json to print JSON instead of text.
+Run "daytime <command> -h" for the flags of a command.
+`
+
+// Exit codes.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// errUsage reports invalid command-line arguments.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// command runs a subcommand with its flag set and output.
+type command func(fs *flag.FlagSet, args []string, out *output) error
+
+var commands = map[string]command{
+	"parse":   runParse,
+	"add":     runAdd,
+	"diff":    runDiff,
+	"between": runBetween,
+	"convert": runConvert,
+	"steps":   runSteps,
+	"filter":  runFilter,
+}
+
+// run executes the command line and returns the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+	name := args[0]
+	if name == "help" || name == "-h" || name == "--help" {
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "daytime: unknown command %q\n\n%s", name, usage)
+		return exitUsage
+	}
+
+	fs := flag.NewFlagSet("daytime "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	out := &output{w: stdout}
+	fs.BoolVar(&out.json, "json", false, "print JSON instead of text")
+
+	err := cmd(fs, args[1:], out)
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.Is(err, errUsage):
+		fmt.Fprintf(stderr, "daytime %s: %v\n", name, err)
+		fs.Usage()
+		return exitUsage
+	default:
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+}
+
+// --- Commands ---
+
+func runParse(fs *flag.FlagSet, args []string, out *output) error {
+	layout := fs.String("layout", "", "also format the result with this layout, e.g. \"3:04 PM\"")
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	d, err := daytime.ParseLenient(args[0])
+	if err != nil {
+		return err
+	}
+
+	result := struct {
+		Daytime   daytime.Daytime `json:"daytime"`
+		Seconds   int             `json:"seconds"`
+		Formatted string          `json:"formatted,omitempty"`
+	}{Daytime: d, Seconds: int(d)}
+	text := d.String()
+	if *layout != "" {
+		result.Formatted = d.FormatLayout(*layout)
+		text += " " + result.Formatted
+	}
+	return out.print(text, result)
+}
+
+func runAdd(fs *flag.FlagSet, args []string, out *output) error {
+	args, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return err
+	}
+
+	d, err := daytime.ParseLenient(args[0])
+	if err != nil {
+		return err
+	}
+	dur, err := parseDuration(args[1])
+	if err != nil {
+		return err
+	}
+
+	result, days := d.AddDuration(dur)
+	return out.print(fmt.Sprintf("%s %+dd", result, days), struct {
+		Daytime daytime.Daytime `json:"daytime"`
+		Days    int             `json:"days"`
+	}{result, days})
+}
+
+func runDiff(fs *flag.FlagSet, args []string, out *output) error {
+	args, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return err
+	}
+
+	a, err := daytime.ParseLenient(args[0])
+	if err != nil {
+		return err
+	}
+	b, err := daytime.ParseLenient(args[1])
+	if err != nil {
+		return err
+	}
+
+	seconds, days := a.Diff(b)
+	dur := time.Duration(seconds) * time.Second
+	return out.print(fmt.Sprintf("%s %+dd", dur, days), struct {
+		Seconds  int    `json:"seconds"`
+		Duration string `json:"duration"`
+		Days     int    `json:"days"`
+	}{seconds, dur.String(), days})
+}
+
+func runBetween(fs *flag.FlagSet, args []string, out *output) error {
+	args, err := parseArgs(fs, args, 3)
+	if err != nil {
+		return err
+	}
+
+	var ds [3]daytime.Daytime
+	for i, arg := range args {
+		if ds[i], err = daytime.ParseLenient(arg); err != nil {
+			return err
+		}
+	}
+
+	between := ds[0].Between(ds[1], ds[2])
+	return out.print(strconv.FormatBool(between), struct {
+		Between bool `json:"between"`
+	}{between})
+}
+
+func runConvert(fs *flag.FlagSet, args []string, out *output) error {
+	fromName := fs.String("from", "Local", "time zone of the daytime, e.g. Europe/Berlin")
+	toName := fs.String("to", "Local", "time zone to convert to")
+	dateText := fs.String("date", "", "date of the daytime as YYYY-MM-DD (default today in --from)")
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	from, err := time.LoadLocation(*fromName)
+	if err != nil {
+		return fmt.Errorf("%w: --from: %v", errUsage, err)
+	}
+	to, err := time.LoadLocation(*toName)
+	if err != nil {
+		return fmt.Errorf("%w: --to: %v", errUsage, err)
+	}
+	date := time.Now().In(from)
+	if *dateText != "" {
+		if date, err = time.ParseInLocation(time.DateOnly, *dateText, from); err != nil {
+			return fmt.Errorf("%w: --date: %v", errUsage, err)
+		}
+	}
+	d, err := daytime.ParseLenient(args[0])
+	if err != nil {
+		return err
+	}
+
+	result, days := daytime.Convert(d, date, from, to)
+	return out.print(fmt.Sprintf("%s %+dd", result, days), struct {
+		Daytime daytime.Daytime `json:"daytime"`
+		Days    int             `json:"days"`
+		Date    string          `json:"date"`
+		From    string          `json:"from"`
+		To      string          `json:"to"`
+	}{result, days, date.Format(time.DateOnly), from.String(), to.String()})
+}
+
+func runSteps(fs *flag.FlagSet, args []string, out *output) error {
+	closed := fs.Bool("closed", false, "include the end if it falls on a step, e.g. 24:00:00")
+	args, err := parseArgs(fs, args, 3)
+	if err != nil {
+		return err
+	}
+
+	start, err := daytime.ParseLenient(args[0])
+	if err != nil {
+		return err
+	}
+	end, err := daytime.ParseLenient(args[1])
+	if err != nil {
+		return err
+	}
+	step, err := parseDuration(args[2])
+	if err != nil {
+		return err
+	}
+	if step < time.Second {
+		return fmt.Errorf("%w: step must be at least 1s", errUsage)
+	}
+
+	bounds := daytime.ClosedOpen
+	if *closed {
+		bounds = daytime.Closed
+	}
+	r, err := daytime.NewRange(start, end, bounds)
+	if err != nil {
+		return err
+	}
+
+	ds := slices.Collect(r.Steps(step))
+	if ds == nil {
+		ds = []daytime.Daytime{}
+	}
+	lines := make([]string, len(ds))
+	for i, d := range ds {
+		lines[i] = d.String()
+	}
+	return out.print(strings.Join(lines, "\n"), ds)
+}
+
+// --- Helper functions ---
+
+// output prints results as text or JSON.
+type output struct {
+	w    io.Writer
+	json bool
+}
+
+// print writes the text, or the value as JSON.
+func (o *output) print(text string, value any) error {
+	if o.json {
+		enc := json.NewEncoder(o.w)
+		return enc.Encode(value)
+	}
+	if text == "" {
+		return nil
+	}
+	_, err := fmt.Fprintln(o.w, text)
+	return err
+}
+
+// parseArgs parses flags placed anywhere among the arguments and returns exactly
+// n positional arguments. Arguments that look like negative numbers, such as "-2h",
+// are positional.
+func parseArgs(fs *flag.FlagSet, args []string, n int) ([]string, error) {
+	var positional []string
+	for len(args) > 0 {
+		arg := args[0]
+		switch {
+		case arg == "--":
+			positional = append(positional, args[1:]...)
+			args = nil
+		case !strings.HasPrefix(arg, "-") || len(arg) > 1 && arg[1] >= '0' && arg[1] <= '9':
+			positional = append(positional, arg)
+			args = args[1:]
+		default:
+			if err := fs.Parse(args); err != nil {
+				if errors.Is(err, flag.ErrHelp) {
+					return nil, err
+				}
+				return nil, fmt.Errorf("%w: %v", errUsage, err)
+			}
+			args = fs.Args()
+		}
+	}
+	if len(positional) != n {
+		return nil, fmt.Errorf("%w: expected %d arguments, got %d", errUsage, n, len(positional))
+	}
+	return positional, nil
+}
+
+// parseDuration parses a Go duration such as "1h30m", or whole seconds such as "90".
+func parseDuration(s string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(s); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	dur, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid duration %q", errUsage, s)
+	}
+	return dur, nil
+}
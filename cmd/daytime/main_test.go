@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{"Parse lenient", []string{"parse", "9:30pm"}, exitOK, "21:30:00\n", ""},
+		{"Parse with layout", []string{"parse", "--layout", "3:04 PM", "21:30"}, exitOK, "21:30:00 9:30 PM\n", ""},
+		{"Parse JSON", []string{"parse", "--json", "24:00"}, exitOK, `{"daytime":"24:00:00","seconds":86400}` + "\n", ""},
+		{"Parse error", []string{"parse", "25:00"}, exitError, "", "hour out of range"},
+		{"Add with carry", []string{"add", "23:00:00", "2h"}, exitOK, "01:00:00 +1d\n", ""},
+		{"Add to EndOfDay", []string{"add", "23:00:00", "1h"}, exitOK, "24:00:00 +0d\n", ""},
+		{"Add negative", []string{"add", "01:00", "-2h"}, exitOK, "23:00:00 -1d\n", ""},
+		{"Add seconds", []string{"add", "12:00", "90"}, exitOK, "12:01:30 +0d\n", ""},
+		{"Add JSON after arguments", []string{"add", "23:00:00", "2h", "--json"}, exitOK, `{"daytime":"01:00:00","days":1}` + "\n", ""},
+		{"Add bad duration", []string{"add", "23:00:00", "soon"}, exitUsage, "", "invalid duration"},
+		{"Diff", []string{"diff", "18:00", "09:30"}, exitOK, "8h30m0s +0d\n", ""},
+		{"Diff across midnight", []string{"diff", "01:00", "23:00"}, exitOK, "2h0m0s -1d\n", ""},
+		{"Diff JSON", []string{"diff", "--json", "01:00", "23:00"}, exitOK, `{"seconds":7200,"duration":"2h0m0s","days":-1}` + "\n", ""},
+		{"Between", []string{"between", "12:00", "09:00", "17:00"}, exitOK, "true\n", ""},
+		{"Between wraparound", []string{"between", "12:00", "22:00", "06:00"}, exitOK, "false\n", ""},
+		{"Between JSON", []string{"between", "--json", "23:00", "22:00", "06:00"}, exitOK, `{"between":true}` + "\n", ""},
+		{"Convert", []string{"convert", "--from", "Europe/Berlin", "--to", "America/New_York", "--date", "2025-06-01", "9:00"}, exitOK, "03:00:00 +0d\n", ""},
+		{"Convert to next day", []string{"convert", "--from=Europe/Berlin", "--to=Asia/Tokyo", "--date=2025-06-01", "18:00"}, exitOK, "01:00:00 +1d\n", ""},
+		{
+			"Convert JSON", []string{"convert", "--json", "--from", "Europe/Berlin", "--to", "UTC", "--date", "2025-01-15", "9:00"}, exitOK,
+			`{"daytime":"08:00:00","days":0,"date":"2025-01-15","from":"Europe/Berlin","to":"UTC"}` + "\n", "",
+		},
+		{"Convert unknown zone", []string{"convert", "--from", "Mars/Olympus", "9:00"}, exitUsage, "", "--from"},
+		{"Convert bad date", []string{"convert", "--from", "UTC", "--date", "June 1", "9:00"}, exitUsage, "", "--date"},
+		{"Steps", []string{"steps", "08:00", "09:00", "20m"}, exitOK, "08:00:00\n08:20:00\n08:40:00\n", ""},
+		{"Steps closed to EndOfDay", []string{"steps", "--closed", "23:00", "24:00", "30m"}, exitOK, "23:00:00\n23:30:00\n24:00:00\n", ""},
+		{"Steps wraparound", []string{"steps", "23:00", "01:00", "1h"}, exitOK, "23:00:00\n00:00:00\n", ""},
+		{"Steps JSON", []string{"steps", "--json", "08:00", "09:00", "30m"}, exitOK, `["08:00:00","08:30:00"]` + "\n", ""},
+		{"Steps JSON empty", []string{"steps", "--json", "08:00", "08:00", "30m"}, exitOK, "[]\n", ""},
+		{"Steps too small", []string{"steps", "08:00", "09:00", "1ms"}, exitUsage, "", "at least 1s"},
+		{"Missing arguments", []string{"diff", "01:00"}, exitUsage, "", "expected 2 arguments"},
+		{"Unknown flag", []string{"add", "--verbose", "01:00", "1h"}, exitUsage, "", "flag provided but not defined"},
+		{"Flag help", []string{"add", "-h"}, exitOK, "", "Usage of daytime add"},
+		{"Unknown command", []string{"frobnicate"}, exitUsage, "", "unknown command"},
+		{"No command", nil, exitUsage, "", "usage: daytime"},
+		{"Help", []string{"help"}, exitOK, "usage: daytime", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.code {
+				t.Errorf("run() got exit code %d, want %d (stderr %q)", code, tt.code, stderr.String())
+			}
+			// Complete lines must match exactly, anything else is a prefix.
+			got := stdout.String()
+			if strings.HasSuffix(tt.stdout, "\n") && got != tt.stdout || !strings.HasPrefix(got, tt.stdout) {
+				t.Errorf("run() got stdout %q, want %q", got, tt.stdout)
+			}
+			if !strings.Contains(stderr.String(), tt.stderr) {
+				t.Errorf("run() got stderr %q, want it to contain %q", stderr.String(), tt.stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Command daytimed serves the daytime package over HTTP with JSON responses.
+//
+// Usage:
+//
+//	daytimed [-addr :8080] [-config hours.json]
+//
+// Endpoints (all GET, parameters in the query string):
+//
+//	/parse?value=09:30:00                       parse a daytime
+//	/format?value=09:30:00&layout=3:04 PM       format a daytime
+//	/add?value=23:00:00&duration=2h             add a duration, with the day carry
+//	/diff?a=01:00:00&b=23:00:00                 subtract b from a, with the day carry
+//	/between?value=23:00:00&start=22:00:00&end=06:00:00
+//	/open?at=2025-06-01T12:00:00Z               whether the opening hours are open (default now)
+//	/next-open?after=2025-06-01T12:00:00Z       when the opening hours open next
+//	/next-close?after=2025-06-01T12:00:00Z      when the opening hours close next
+//
+// The opening-hours endpoints need a configuration file such as:
+//
+//	{
+//	  "timezone": "Europe/Berlin",
+//	  "weekly": {"monday": ["09:00:00-17:00:00"], "saturday": ["10:00:00-14:00:00"]},
+//	  "exceptions": [{"date": "2025-12-25", "closed": true}]
+//	}
+//
+// Errors are reported with a 4xx status and a body such as
+// {"error": {"code": "invalid_format", "message": "...", "operation": "Parse", "value": "25:00"}}.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	configPath := flag.String("config", "", "opening-hours configuration file")
+	flag.Parse()
+
+	s := &server{clock: daytime.SystemClock}
+	if *configPath != "" {
+		f, err := os.Open(*configPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		s.schedule, s.location, err = loadConfig(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("%s: %v", *configPath, err)
+		}
+	}
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, s.routes()))
+}
+
+// config is the opening-hours configuration file.
+type config struct {
+	Timezone   string              `json:"timezone"`
+	Weekly     map[string][]string `json:"weekly"`
+	Exceptions json.RawMessage     `json:"exceptions"`
+}
+
+// loadConfig reads an opening-hours configuration.
+//
+// The weekly hours map lowercase English weekday names to ranges in ParseRange form,
+// and the exceptions use the JSON form of ReadExceptions. A missing timezone means UTC.
+func loadConfig(r io.Reader) (*daytime.Schedule, *time.Location, error) {
+	var c config
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return nil, nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	loc := time.UTC
+	if c.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(c.Timezone); err != nil {
+			return nil, nil, fmt.Errorf("timezone: %w", err)
+		}
+	}
+
+	var weekly daytime.WeeklySchedule
+	for name, texts := range c.Weekly {
+		day, ok := parseWeekday(name)
+		if !ok {
+			return nil, nil, fmt.Errorf("weekly: unknown weekday %q", name)
+		}
+		for _, text := range texts {
+			r, err := daytime.ParseRange(text)
+			if err != nil {
+				return nil, nil, fmt.Errorf("weekly: %s: %w", name, err)
+			}
+			if err := weekly.Add(day, r); err != nil {
+				return nil, nil, fmt.Errorf("weekly: %s: %w", name, err)
+			}
+		}
+	}
+
+	var exceptions []daytime.Exception
+	if len(c.Exceptions) > 0 {
+		var err error
+		if exceptions, err = daytime.ReadExceptions(bytes.NewReader(c.Exceptions)); err != nil {
+			return nil, nil, fmt.Errorf("exceptions: %w", err)
+		}
+	}
+	return daytime.NewSchedule(weekly, exceptions...), loc, nil
+}
+
+// parseWeekday returns the weekday with the case-insensitive English name.
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// errorCodes maps sentinel errors of the daytime package to error codes of the API.
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{daytime.ErrInvalidFormat, "invalid_format"},
+	{daytime.ErrInvalidTimeComponent, "invalid_time_component"},
+	{daytime.ErrEndOfDayExceeded, "end_of_day_exceeded"},
+	{daytime.ErrValueOutOfRange, "value_out_of_range"},
+	{daytime.ErrNonexistentTime, "nonexistent_time"},
+	{daytime.ErrAmbiguousTime, "ambiguous_time"},
+}
+
+// errorCode returns the API error code of err.
+func errorCode(err error) string {
+	for _, entry := range errorCodes {
+		if errors.Is(err, entry.err) {
+			return entry.code
+		}
+	}
+	return "invalid_request"
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+// server handles the HTTP API.
+type server struct {
+	// schedule holds the opening hours, or nil if none are configured.
+	schedule *daytime.Schedule
+
+	// location is the time zone of the opening hours.
+	location *time.Location
+
+	// clock provides the current time for the opening-hours endpoints.
+	clock daytime.Clock
+}
+
+// routes returns the handler serving all endpoints.
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /parse", s.handle(s.parse))
+	mux.HandleFunc("GET /format", s.handle(s.format))
+	mux.HandleFunc("GET /add", s.handle(s.add))
+	mux.HandleFunc("GET /diff", s.handle(s.diff))
+	mux.HandleFunc("GET /between", s.handle(s.between))
+	mux.HandleFunc("GET /open", s.handle(s.open))
+	mux.HandleFunc("GET /next-open", s.handle(s.nextOpen))
+	mux.HandleFunc("GET /next-close", s.handle(s.nextClose))
+	return mux
+}
+
+// apiError is an error reported to the client.
+type apiError struct {
+	status    int
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	Operation string `json:"operation,omitempty"`
+	Value     any    `json:"value,omitempty"`
+}
+
+func (e *apiError) Error() string {
+	return e.Message
+}
+
+// badRequest creates an API error from an error, taking the operation and value from
+// a *daytime.Error if err wraps one.
+func badRequest(err error) *apiError {
+	e := &apiError{status: http.StatusBadRequest, Code: errorCode(err), Message: err.Error()}
+	var derr *daytime.Error
+	if errors.As(err, &derr) {
+		e.Operation = derr.Operation()
+		e.Value = derr.Value()
+	}
+	return e
+}
+
+// handle adapts an endpoint returning a response value or error to an http.HandlerFunc.
+func (s *server) handle(endpoint func(r *http.Request) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		value, err := endpoint(r)
+		if err != nil {
+			var e *apiError
+			if !errors.As(err, &e) {
+				e = badRequest(err)
+			}
+			writeJSON(w, e.status, struct {
+				Error *apiError `json:"error"`
+			}{e})
+			return
+		}
+		writeJSON(w, http.StatusOK, value)
+	}
+}
+
+// writeJSON writes the value as a JSON response.
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// --- Endpoints ---
+
+func (s *server) parse(r *http.Request) (any, error) {
+	d, err := daytimeParam(r, "value")
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Daytime daytime.Daytime `json:"daytime"`
+		Seconds int             `json:"seconds"`
+	}{d, int(d)}, nil
+}
+
+func (s *server) format(r *http.Request) (any, error) {
+	d, err := daytimeParam(r, "value")
+	if err != nil {
+		return nil, err
+	}
+	formatted := d.String()
+	if layout := r.URL.Query().Get("layout"); layout != "" {
+		formatted = d.FormatLayout(layout)
+	}
+	return struct {
+		Formatted string `json:"formatted"`
+	}{formatted}, nil
+}
+
+func (s *server) add(r *http.Request) (any, error) {
+	d, err := daytimeParam(r, "value")
+	if err != nil {
+		return nil, err
+	}
+	dur, err := durationParam(r, "duration")
+	if err != nil {
+		return nil, err
+	}
+
+	result, days := d.Add(int(dur / time.Second))
+	return struct {
+		Daytime daytime.Daytime `json:"daytime"`
+		Days    int             `json:"days"`
+	}{result, days}, nil
+}
+
+func (s *server) diff(r *http.Request) (any, error) {
+	a, err := daytimeParam(r, "a")
+	if err != nil {
+		return nil, err
+	}
+	b, err := daytimeParam(r, "b")
+	if err != nil {
+		return nil, err
+	}
+
+	seconds, days := a.Diff(b)
+	return struct {
+		Seconds  int    `json:"seconds"`
+		Duration string `json:"duration"`
+		Days     int    `json:"days"`
+	}{seconds, (time.Duration(seconds) * time.Second).String(), days}, nil
+}
+
+func (s *server) between(r *http.Request) (any, error) {
+	var ds [3]daytime.Daytime
+	for i, name := range []string{"value", "start", "end"} {
+		var err error
+		if ds[i], err = daytimeParam(r, name); err != nil {
+			return nil, err
+		}
+	}
+	return struct {
+		Between bool `json:"between"`
+	}{ds[0].Between(ds[1], ds[2])}, nil
+}
+
+func (s *server) open(r *http.Request) (any, error) {
+	at, err := s.instantParam(r, "at")
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Open    bool            `json:"open"`
+		At      time.Time       `json:"at"`
+		Daytime daytime.Daytime `json:"daytime"`
+	}{s.schedule.IsOpen(at), at, daytime.FromTime(at)}, nil
+}
+
+func (s *server) nextOpen(r *http.Request) (any, error) {
+	after, err := s.instantParam(r, "after")
+	if err != nil {
+		return nil, err
+	}
+	next, found := s.schedule.NextOpen(after)
+	return nextResponse(after, next, found), nil
+}
+
+func (s *server) nextClose(r *http.Request) (any, error) {
+	after, err := s.instantParam(r, "after")
+	if err != nil {
+		return nil, err
+	}
+	next, found := s.schedule.NextClose(after)
+	return nextResponse(after, next, found), nil
+}
+
+// --- Helper functions ---
+
+// nextResponse is the response of the next-open and next-close endpoints.
+//
+// The next instant is reported as a daytime and the number of days after the date of after
+// by daytime.FromTimeOffset, as in Schedule.NextOpenDaytime.
+func nextResponse(after, next time.Time, found bool) any {
+	type response struct {
+		Found   bool             `json:"found"`
+		At      *time.Time       `json:"at,omitempty"`
+		Daytime *daytime.Daytime `json:"daytime,omitempty"`
+		Days    int              `json:"days"`
+	}
+	if !found {
+		return response{}
+	}
+
+	next = next.In(after.Location())
+	d, days := daytime.FromTimeOffset(after, next)
+	return response{Found: true, At: &next, Daytime: &d, Days: days}
+}
+
+// daytimeParam parses the named query parameter with daytime.Parse.
+func daytimeParam(r *http.Request, name string) (daytime.Daytime, error) {
+	text, err := requiredParam(r, name)
+	if err != nil {
+		return 0, err
+	}
+	return daytime.Parse(text)
+}
+
+// durationParam parses the named query parameter as a Go duration or whole seconds.
+func durationParam(r *http.Request, name string) (time.Duration, error) {
+	text, err := requiredParam(r, name)
+	if err != nil {
+		return 0, err
+	}
+	if seconds, err := strconv.Atoi(text); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	dur, err := time.ParseDuration(text)
+	if err != nil {
+		return 0, &apiError{
+			status:  http.StatusBadRequest,
+			Code:    "invalid_format",
+			Message: fmt.Sprintf("parameter %q must be a duration such as 90m or whole seconds", name),
+			Value:   text,
+		}
+	}
+	return dur, nil
+}
+
+// instantParam parses the named RFC 3339 query parameter, defaulting to the current time,
+// and returns it in the location of the opening hours.
+func (s *server) instantParam(r *http.Request, name string) (time.Time, error) {
+	if s.schedule == nil {
+		return time.Time{}, &apiError{status: http.StatusNotFound, Code: "no_schedule", Message: "no opening hours configured"}
+	}
+
+	text := r.URL.Query().Get(name)
+	if text == "" {
+		return s.clock.Now().In(s.location), nil
+	}
+	t, err := time.Parse(time.RFC3339, text)
+	if err != nil {
+		return time.Time{}, &apiError{
+			status:  http.StatusBadRequest,
+			Code:    "invalid_format",
+			Message: fmt.Sprintf("parameter %q must be an RFC 3339 time", name),
+			Value:   text,
+		}
+	}
+	return t.In(s.location), nil
+}
+
+// requiredParam returns the named query parameter, which must be present.
+func requiredParam(r *http.Request, name string) (string, error) {
+	query := r.URL.Query()
+	if !query.Has(name) {
+		return "", &apiError{
+			status:  http.StatusBadRequest,
+			Code:    "missing_parameter",
+			Message: fmt.Sprintf("missing parameter %q", name),
+		}
+	}
+	return query.Get(name), nil
+}
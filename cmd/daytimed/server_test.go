@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/pacrock/daytime"
+)
+
+const testConfig = `{
+	"timezone": "Europe/Berlin",
+	"weekly": {
+		"Monday": ["09:00:00-17:00:00"],
+		"tuesday": ["09:00:00-17:00:00"],
+		"friday": ["18:00:00-02:00:00"]
+	},
+	"exceptions": [
+		{"date": "2025-06-03", "closed": true},
+		{"date": "2025-06-07", "ranges": ["20:00:00-24:00:00"]}
+	]
+}`
+
+// newTestServer serves the test configuration with a fake clock.
+func newTestServer(t *testing.T, now time.Time) *httptest.Server {
+	t.Helper()
+	schedule, loc, err := loadConfig(strings.NewReader(testConfig))
+	if err != nil {
+		t.Fatalf("loadConfig() failed: %v", err)
+	}
+	s := &server{schedule: schedule, location: loc, clock: daytime.NewFakeClock(now)}
+	ts := httptest.NewServer(s.routes())
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// get requests the path and decodes the JSON response.
+func get(t *testing.T, ts *httptest.Server, path string) (int, map[string]any) {
+	t.Helper()
+	resp, err := http.Get(ts.URL + path)
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", path, err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("GET %s got Content-Type %q, want application/json", path, ct)
+	}
+	var body map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("GET %s returned invalid JSON: %v", path, err)
+	}
+	return resp.StatusCode, body
+}
+
+// query builds a query string from name-value pairs.
+func query(pairs ...string) string {
+	values := url.Values{}
+	for i := 0; i < len(pairs); i += 2 {
+		values.Set(pairs[i], pairs[i+1])
+	}
+	return "?" + values.Encode()
+}
+
+func TestServer_Endpoints(t *testing.T) {
+	// Monday, 2025-06-02 12:00 in Berlin.
+	ts := newTestServer(t, time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		want   map[string]any
+	}{
+		{"Parse", "/parse" + query("value", "09:30:00"), 200, map[string]any{"daytime": "09:30:00", "seconds": 34200.0}},
+		{"Parse seconds", "/parse" + query("value", "86400"), 200, map[string]any{"daytime": "24:00:00", "seconds": 86400.0}},
+		{"Format", "/format" + query("value", "21:30:00", "layout", "3:04 PM"), 200, map[string]any{"formatted": "9:30 PM"}},
+		{"Format default", "/format" + query("value", "3600"), 200, map[string]any{"formatted": "01:00:00"}},
+		{"Add", "/add" + query("value", "23:00:00", "duration", "2h"), 200, map[string]any{"daytime": "01:00:00", "days": 1.0}},
+		{"Add seconds", "/add" + query("value", "23:00:00", "duration", "-3600"), 200, map[string]any{"daytime": "22:00:00", "days": 0.0}},
+		{"Diff", "/diff" + query("a", "01:00:00", "b", "23:00:00"), 200, map[string]any{"seconds": 7200.0, "duration": "2h0m0s", "days": -1.0}},
+		{"Between", "/between" + query("value", "23:00:00", "start", "22:00:00", "end", "06:00:00"), 200, map[string]any{"between": true}},
+		{"Open now", "/open", 200, map[string]any{"open": true, "at": "2025-06-02T12:00:00+02:00", "daytime": "12:00:00"}},
+		{"Open at", "/open" + query("at", "2025-06-02T18:00:00+02:00"), 200, map[string]any{"open": false, "at": "2025-06-02T18:00:00+02:00", "daytime": "18:00:00"}},
+		{"Open after midnight", "/open" + query("at", "2025-06-07T01:00:00+02:00"), 200, map[string]any{"open": true, "at": "2025-06-07T01:00:00+02:00", "daytime": "01:00:00"}},
+		{"Next open skips exception", "/next-open" + query("after", "2025-06-02T18:00:00+02:00"), 200, map[string]any{"found": true, "at": "2025-06-06T18:00:00+02:00", "daytime": "18:00:00", "days": 4.0}},
+		{"Next close after midnight", "/next-close" + query("after", "2025-06-06T20:00:00+02:00"), 200, map[string]any{"found": true, "at": "2025-06-07T02:00:00+02:00", "daytime": "02:00:00", "days": 1.0}},
+		{"Next close at end of day", "/next-close" + query("after", "2025-06-07T21:00:00+02:00"), 200, map[string]any{"found": true, "at": "2025-06-08T00:00:00+02:00", "daytime": "24:00:00", "days": 0.0}},
+		{"Next close", "/next-close", 200, map[string]any{"found": true, "at": "2025-06-02T17:00:00+02:00", "daytime": "17:00:00", "days": 0.0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := get(t, ts, tt.path)
+			if status != tt.status {
+				t.Errorf("GET %s got status %d, want %d (body %v)", tt.path, status, tt.status, body)
+			}
+			for key, want := range tt.want {
+				if body[key] != want {
+					t.Errorf("GET %s got %s = %v, want %v", tt.path, key, body[key], want)
+				}
+			}
+			if len(body) != len(tt.want) {
+				t.Errorf("GET %s got %v, want %v", tt.path, body, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	ts := newTestServer(t, time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC))
+	noSchedule := httptest.NewServer((&server{clock: daytime.SystemClock}).routes())
+	defer noSchedule.Close()
+
+	tests := []struct {
+		name      string
+		ts        *httptest.Server
+		path      string
+		status    int
+		code      string
+		operation string
+		value     any
+	}{
+		{"Invalid daytime", ts, "/parse" + query("value", "25:00:00"), 400, "invalid_format", "Parse", "25:00:00"},
+		{"Empty daytime", ts, "/add" + query("value", "", "duration", "1h"), 400, "invalid_format", "Parse", ""},
+		{"Missing parameter", ts, "/between" + query("value", "12:00:00", "start", "09:00:00"), 400, "missing_parameter", "", nil},
+		{"Invalid duration", ts, "/add" + query("value", "12:00:00", "duration", "soon"), 400, "invalid_format", "", "soon"},
+		{"Invalid instant", ts, "/open" + query("at", "noon"), 400, "invalid_format", "", "noon"},
+		{"No schedule", noSchedule, "/next-open", 404, "no_schedule", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := get(t, tt.ts, tt.path)
+			if status != tt.status {
+				t.Errorf("GET %s got status %d, want %d", tt.path, status, tt.status)
+			}
+			e, ok := body["error"].(map[string]any)
+			if !ok {
+				t.Fatalf("GET %s got %v, want an error object", tt.path, body)
+			}
+			if e["code"] != tt.code || e["message"] == "" {
+				t.Errorf("GET %s got error %v, want code %q and a message", tt.path, e, tt.code)
+			}
+			if operation, _ := e["operation"].(string); operation != tt.operation {
+				t.Errorf("GET %s got operation %q, want %q", tt.path, operation, tt.operation)
+			}
+			if e["value"] != tt.value {
+				t.Errorf("GET %s got value %v, want %v", tt.path, e["value"], tt.value)
+			}
+		})
+	}
+
+	resp, err := http.Post(ts.URL+"/parse", "text/plain", nil)
+	if err != nil {
+		t.Fatalf("POST /parse failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST /parse got status %d, want %d", resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{"Minimal", `{}`, ""},
+		{"Unknown weekday", `{"weekly": {"funday": ["09:00:00-17:00:00"]}}`, "unknown weekday"},
+		{"Invalid range", `{"weekly": {"monday": ["9 to 5"]}}`, "invalid format"},
+		{"Unknown timezone", `{"timezone": "Mars/Olympus"}`, "timezone"},
+		{"Invalid exceptions", `{"exceptions": [{"date": "christmas"}]}`, "exceptions"},
+		{"Unknown field", `{"hours": {}}`, "unknown field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, loc, err := loadConfig(strings.NewReader(tt.config))
+			if tt.err == "" {
+				if err != nil || loc != time.UTC {
+					t.Errorf("loadConfig() got (%v, %v), want UTC and no error", loc, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("loadConfig() got error %v, want it to contain %q", err, tt.err)
+			}
+		})
+	}
+}
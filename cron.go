@@ -0,0 +1,305 @@
+package daytime
+
+import (
+	"iter"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// Cron matches the daytimes selected by the time-of-day fields of a cron expression.
+//
+// The zero value matches nothing; use ParseCron to create a Cron.
+type Cron struct {
+	seconds uint64
+	minutes uint64
+	hours   uint64
+}
+
+// cronField describes the time-of-day field of a cron expression.
+type cronField struct {
+	name string
+	max  int
+}
+
+var (
+	cronSeconds = cronField{"second", 59}
+	cronMinutes = cronField{"minute", 59}
+	cronHours   = cronField{"hour", 23}
+)
+
+// ParseCron parses the time-of-day part of a cron expression.
+//
+// Supported forms:
+//
+//   - six fields "second minute hour day month weekday" (e.g., "0 */15 8-18 * * *")
+//   - five fields "minute hour day month weekday", matching at second zero
+//   - the macros "@hourly", "@daily" and "@midnight"
+//
+// Each time-of-day field is a comma-separated list of "*", a value "n", a range "a-b",
+// optionally followed by a step "/s"; "n/s" means from n to the largest value.
+// The day, month and weekday fields are required but not interpreted, since a Cron
+// only describes times of day.
+//
+// Errors wrap a *ParseError reporting the offset of the problem and are errors.Is-compatible
+// with ErrInvalidFormat and ErrInvalidTimeComponent.
+func ParseCron(expr string) (Cron, error) {
+	c, err := parseCron(expr)
+	if err != nil {
+		return Cron{}, errorf("ParseCron", expr, err)
+	}
+	return c, nil
+}
+
+// MustCron parses a cron expression, panicking on error.
+func MustCron(expr string) Cron {
+	c, err := ParseCron(expr)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Matches reports whether the daytime is selected by the cron expression.
+//
+// EndOfDay matches if 00:00:00 does, since both denote midnight.
+func (c Cron) Matches(d Daytime) bool {
+	if !d.Valid() {
+		return false
+	}
+	if d == EndOfDay {
+		d = StartOfDay
+	}
+	hour, minute, second := d.Clock()
+	return c.hours&(1<<hour) != 0 && c.minutes&(1<<minute) != 0 && c.seconds&(1<<second) != 0
+}
+
+// Next returns the first matching daytime after d.
+//
+// Returns the daytime and the number of day boundaries crossed, following the same rules
+// as Add: a match at midnight after the day is EndOfDay with zero days. EndOfDay is treated
+// as midnight at the start of the next day. Invalid daytimes and a Cron matching
+// nothing return d unchanged with zero days.
+func (c Cron) Next(d Daytime) (Daytime, int) {
+	if !d.Valid() {
+		return d, 0
+	}
+	for day := range 3 {
+		from := max(int(d)+1-day*secondsInDay, 0)
+		if from >= secondsInDay {
+			continue
+		}
+		if next, ok := c.nextInDay(from); ok {
+			return d.Add(day*secondsInDay + next - int(d))
+		}
+	}
+	return d, 0
+}
+
+// All returns the matching daytimes of a day in ascending order, from 00:00:00 to 23:59:59.
+func (c Cron) All() iter.Seq[Daytime] {
+	return func(yield func(Daytime) bool) {
+		for hour := range eachBit(c.hours) {
+			for minute := range eachBit(c.minutes) {
+				for second := range eachBit(c.seconds) {
+					if !yield(Daytime(hour*3600 + minute*60 + second)) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// Count returns the number of matching daytimes of a day.
+func (c Cron) Count() int {
+	return bits.OnesCount64(c.hours) * bits.OnesCount64(c.minutes) * bits.OnesCount64(c.seconds)
+}
+
+// String returns the time-of-day fields in "second minute hour" form with explicit
+// values and ranges, e.g. "0 0,15,30,45 8-18".
+func (c Cron) String() string {
+	return formatCronField(c.seconds, cronSeconds.max) + " " +
+		formatCronField(c.minutes, cronMinutes.max) + " " +
+		formatCronField(c.hours, cronHours.max)
+}
+
+// --- Helper functions ---
+
+// parseCron implements ParseCron.
+func parseCron(expr string) (Cron, error) {
+	switch strings.TrimSpace(expr) {
+	case "@hourly":
+		return Cron{seconds: 1, minutes: 1, hours: 1<<24 - 1}, nil
+	case "@daily", "@midnight":
+		return Cron{seconds: 1, minutes: 1, hours: 1}, nil
+	}
+
+	texts, offsets := cronFields(expr)
+	var c Cron
+	var err error
+	switch len(texts) {
+	case 5:
+		c.seconds = 1
+		if c.minutes, err = parseCronField(expr, texts[0], offsets[0], cronMinutes); err != nil {
+			return Cron{}, err
+		}
+		if c.hours, err = parseCronField(expr, texts[1], offsets[1], cronHours); err != nil {
+			return Cron{}, err
+		}
+	case 6:
+		if c.seconds, err = parseCronField(expr, texts[0], offsets[0], cronSeconds); err != nil {
+			return Cron{}, err
+		}
+		if c.minutes, err = parseCronField(expr, texts[1], offsets[1], cronMinutes); err != nil {
+			return Cron{}, err
+		}
+		if c.hours, err = parseCronField(expr, texts[2], offsets[2], cronHours); err != nil {
+			return Cron{}, err
+		}
+	default:
+		return Cron{}, &ParseError{Input: expr, Offset: 0, Msg: "expected 5 or 6 fields", Err: ErrInvalidFormat}
+	}
+	return c, nil
+}
+
+// cronFields splits the expression at spaces, returning the fields and their offsets.
+func cronFields(expr string) (texts []string, offsets []int) {
+	start := -1
+	for i := 0; i <= len(expr); i++ {
+		if i == len(expr) || expr[i] == ' ' || expr[i] == '\t' {
+			if start >= 0 {
+				texts = append(texts, expr[start:i])
+				offsets = append(offsets, start)
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	return texts, offsets
+}
+
+// parseCronField parses a time-of-day field starting at offset of expr into a bit set.
+func parseCronField(expr, text string, offset int, field cronField) (uint64, error) {
+	fail := func(pos int, err error, msg string) error {
+		return &ParseError{Input: expr, Offset: offset + pos, Msg: msg, Err: err}
+	}
+
+	var set uint64
+	pos := 0
+	for part := range strings.SplitSeq(text, ",") {
+		base, stepText, hasStep := strings.Cut(part, "/")
+		low, high := 0, field.max
+		switch {
+		case base == "*":
+		case base == "":
+			return 0, fail(pos, ErrInvalidFormat, "expected "+field.name)
+		default:
+			first, last, isRange := strings.Cut(base, "-")
+			var err error
+			if low, err = cronValue(first, field); err != nil {
+				return 0, fail(pos, err, field.name+" must be in [0, "+strconv.Itoa(field.max)+"]")
+			}
+			high = low
+			if isRange {
+				if high, err = cronValue(last, field); err != nil {
+					return 0, fail(pos+len(first)+1, err, field.name+" must be in [0, "+strconv.Itoa(field.max)+"]")
+				}
+				if high < low {
+					return 0, fail(pos, ErrInvalidFormat, "range start after end")
+				}
+			} else if hasStep {
+				high = field.max
+			}
+		}
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n <= 0 {
+				return 0, fail(pos+len(base)+1, ErrInvalidFormat, "step must be a positive number")
+			}
+			if n > field.max {
+				return 0, fail(pos+len(base)+1, ErrInvalidTimeComponent, "step must be in [1, "+strconv.Itoa(field.max)+"]")
+			}
+			step = n
+		}
+		for v := low; v <= high; v += step {
+			set |= 1 << v
+		}
+		pos += len(part) + 1
+	}
+	return set, nil
+}
+
+// cronValue parses a value of the field.
+func cronValue(text string, field cronField) (int, error) {
+	if text == "" || strings.Trim(text, "0123456789") != "" {
+		return 0, ErrInvalidFormat
+	}
+	if len(text) > 2 || atoi(text) > field.max {
+		return 0, ErrInvalidTimeComponent
+	}
+	return atoi(text), nil
+}
+
+// nextInDay returns the first matching second of the day at or after from.
+func (c Cron) nextInDay(from int) (int, bool) {
+	hour, minute, second := from/3600, from%3600/60, from%60
+	for ; hour < hoursInDay; hour, minute, second = hour+1, 0, 0 {
+		if c.hours&(1<<hour) == 0 {
+			continue
+		}
+		for ; minute < 60; minute, second = minute+1, 0 {
+			if c.minutes&(1<<minute) == 0 {
+				continue
+			}
+			if rest := c.seconds >> second; rest != 0 {
+				return hour*3600 + minute*60 + second + bits.TrailingZeros64(rest), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// eachBit returns the positions of the set bits in ascending order.
+func eachBit(set uint64) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for set != 0 {
+			bit := bits.TrailingZeros64(set)
+			if !yield(bit) {
+				return
+			}
+			set &^= 1 << bit
+		}
+	}
+}
+
+// formatCronField formats a bit set as "*" or a comma-separated list of values and ranges.
+func formatCronField(set uint64, maxValue int) string {
+	if set == 1<<(maxValue+1)-1 {
+		return "*"
+	}
+
+	var parts []string
+	for v := 0; v <= maxValue; v++ {
+		if set&(1<<v) == 0 {
+			continue
+		}
+		end := v
+		for end < maxValue && set&(1<<(end+1)) != 0 {
+			end++
+		}
+		switch {
+		case end == v:
+			parts = append(parts, strconv.Itoa(v))
+		case end == v+1:
+			parts = append(parts, strconv.Itoa(v), strconv.Itoa(end))
+		default:
+			parts = append(parts, strconv.Itoa(v)+"-"+strconv.Itoa(end))
+		}
+		v = end
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,159 @@
+package daytime
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   string
+		want   string
+		count  int
+		err    error
+		offset int
+	}{
+		{"Six fields", "0 */15 8-18 * * *", "0 0,15,30,45 8-18", 44, nil, 0},
+		{"Five fields", "30 9 * * 1-5", "0 30 9", 1, nil, 0},
+		{"Lists and steps", "0,30 5/20 1-7/3 * * *", "0,30 5,25,45 1,4,7", 18, nil, 0},
+		{"Everything", "* * * * * *", "* * *", secondsInDay, nil, 0},
+		{"Extra spaces", "  0  0   12 * * ?  ", "0 0 12", 1, nil, 0},
+		{"Hourly macro", "@hourly", "0 0 *", 24, nil, 0},
+		{"Daily macro", "@daily", "0 0 0", 1, nil, 0},
+		{"Midnight macro", "@midnight", "0 0 0", 1, nil, 0},
+		{"Error: Too few fields", "0 12 * *", "", 0, ErrInvalidFormat, 0},
+		{"Error: Too many fields", "0 0 12 * * * 2025", "", 0, ErrInvalidFormat, 0},
+		{"Error: Hour out of range", "0 0 24 * * *", "", 0, ErrInvalidTimeComponent, 4},
+		{"Error: Range end out of range", "0 0-60 * * * *", "", 0, ErrInvalidTimeComponent, 4},
+		{"Error: Not a number", "0 x 12 * * *", "", 0, ErrInvalidFormat, 2},
+		{"Error: Empty list element", "0 1,,2 12 * * *", "", 0, ErrInvalidFormat, 4},
+		{"Error: Zero step", "0 */0 12 * * *", "", 0, ErrInvalidFormat, 4},
+		{"Error: Step out of range", "0 */60 12 * * *", "", 0, ErrInvalidTimeComponent, 4},
+		{"Error: Huge step", "5/9223372036854775807 * * * * *", "", 0, ErrInvalidTimeComponent, 2},
+		{"Error: Reversed range", "0 0 18-8 * * *", "", 0, ErrInvalidFormat, 4},
+		{"Error: Empty", "", "", 0, ErrInvalidFormat, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseCron(%q) got error %v, want %v", tt.expr, err, tt.err)
+			}
+			if tt.err != nil {
+				var perr *ParseError
+				if !errors.As(err, &perr) || perr.Offset != tt.offset {
+					t.Errorf("ParseCron(%q) got error %v, want offset %d", tt.expr, err, tt.offset)
+				}
+				return
+			}
+			if got := c.String(); got != tt.want {
+				t.Errorf("ParseCron(%q).String() got %q, want %q", tt.expr, got, tt.want)
+			}
+			if got := c.Count(); got != tt.count {
+				t.Errorf("ParseCron(%q).Count() got %d, want %d", tt.expr, got, tt.count)
+			}
+		})
+	}
+}
+
+func TestCron_Matches(t *testing.T) {
+	c := MustCron("0 */15 8-18 * * *")
+
+	tests := []struct {
+		d    Daytime
+		want bool
+	}{
+		{Must(8, 0, 0), true},
+		{Must(18, 45, 0), true},
+		{Must(12, 30, 0), true},
+		{Must(12, 30, 1), false},
+		{Must(12, 31, 0), false},
+		{Must(7, 45, 0), false},
+		{Must(19, 0, 0), false},
+		{D240000, false},
+		{DInvalid, false},
+	}
+
+	for _, tt := range tests {
+		if got := c.Matches(tt.d); got != tt.want {
+			t.Errorf("Matches(%s) got %v, want %v", tt.d, got, tt.want)
+		}
+	}
+
+	if !MustCron("@daily").Matches(D240000) {
+		t.Error("Matches(24:00:00) for @daily got false, want true")
+	}
+	if (Cron{}).Matches(D000000) {
+		t.Error("Matches() for zero Cron got true, want false")
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		d    Daytime
+		want Daytime
+		days int
+	}{
+		{"Later the same hour", "0 */15 8-18 * * *", Must(9, 7, 0), Must(9, 15, 0), 0},
+		{"Match is excluded", "0 */15 8-18 * * *", Must(9, 15, 0), Must(9, 30, 0), 0},
+		{"Next hour", "0 */15 8-18 * * *", Must(9, 45, 0), Must(10, 0, 0), 0},
+		{"Before the window", "0 */15 8-18 * * *", D060000, Must(8, 0, 0), 0},
+		{"After the window carries", "0 */15 8-18 * * *", Must(18, 45, 0), Must(8, 0, 0), 1},
+		{"Every second", "* * * * * *", D123045, Must(12, 30, 46), 0},
+		{"Midnight is EndOfDay", "@daily", D120000, D240000, 0},
+		{"Last second to midnight", "@daily", D235959, D240000, 0},
+		{"From EndOfDay", "0 */15 8-18 * * *", D240000, Must(8, 0, 0), 1},
+		{"From EndOfDay skips midnight", "@hourly", D240000, D010000, 1},
+		{"From EndOfDay to next midnight", "@daily", D240000, D000000, 2},
+		{"Invalid daytime", "@daily", DInvalid, DInvalid, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, days := MustCron(tt.expr).Next(tt.d)
+			if got != tt.want || days != tt.days {
+				t.Errorf("Next(%s) got (%s, %d), want (%s, %d)", tt.d, got, days, tt.want, tt.days)
+			}
+		})
+	}
+
+	if got, days := (Cron{}).Next(D120000); got != D120000 || days != 0 {
+		t.Errorf("Next() for zero Cron got (%s, %d), want (%s, 0)", got, days, D120000)
+	}
+}
+
+func TestCron_All(t *testing.T) {
+	c := MustCron("0,30 0 6,12,18 * * *")
+	want := []Daytime{D060000, Must(6, 0, 30), D120000, Must(12, 0, 30), D180000, Must(18, 0, 30)}
+	if got := slices.Collect(c.All()); !slices.Equal(got, want) {
+		t.Errorf("All() got %v, want %v", got, want)
+	}
+
+	// Intersecting with a window keeps the matches inside it.
+	window := MustRange(Must(11, 0, 0), D180000, ClosedOpen)
+	var inside []Daytime
+	for d := range c.All() {
+		if window.Contains(d) {
+			inside = append(inside, d)
+		}
+	}
+	if want := []Daytime{D120000, Must(12, 0, 30)}; !slices.Equal(inside, want) {
+		t.Errorf("All() inside %s got %v, want %v", window, inside, want)
+	}
+
+	// Next agrees with the enumeration.
+	all := slices.Collect(MustCron("0 */15 8-18 * * *").All())
+	d := all[0]
+	for _, want := range all[1:] {
+		got, days := MustCron("0 */15 8-18 * * *").Next(d)
+		if got != want || days != 0 {
+			t.Fatalf("Next(%s) got (%s, %d), want (%s, 0)", d, got, days, want)
+		}
+		d = got
+	}
+}
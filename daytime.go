@@ -70,6 +70,18 @@ var (
 	// ErrEndOfDayExceeded indicates that 24:00:00 was specified with non-zero minutes or seconds.
 	// This replaces the previous unexported error string for better errors.Is support.
 	ErrEndOfDayExceeded = errors.New("daytime 24:00:00 must have zero minutes and seconds")
+
+	// ErrNonexistentTime indicates the local time falls into a gap skipped by a daylight saving transition.
+	ErrNonexistentTime = errors.New("nonexistent local time")
+
+	// ErrAmbiguousTime indicates the local time occurs twice because of a daylight saving transition.
+	ErrAmbiguousTime = errors.New("ambiguous local time")
+
+	// ErrPolarDay indicates the sun stays above the requested elevation for the whole day.
+	ErrPolarDay = errors.New("sun stays above the elevation all day")
+
+	// ErrPolarNight indicates the sun stays below the requested elevation for the whole day.
+	ErrPolarNight = errors.New("sun stays below the elevation all day")
 )
 
 // errorf creates a new wrapped error with operation context.
@@ -148,6 +160,17 @@ func FromTime(t time.Time) Daytime {
 	return fromTime(t)
 }
 
+// FromTimeOffset expresses the instant t as a daytime and the number of days after the date
+// of ref, evaluated in the location of ref and following the same convention as Add:
+// midnight after the date of ref is EndOfDay with zero days.
+func FromTimeOffset(ref, t time.Time) (Daytime, int) {
+	t = t.In(ref.Location())
+	from, to := keyOf(ref), keyOf(t)
+	days := time.Date(to.year, to.month, to.day, 0, 0, 0, 0, time.UTC).
+		Sub(time.Date(from.year, from.month, from.day, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour)
+	return fromTime(t).Add(int(days) * secondsInDay)
+}
+
 // Parse parses a daytime from string.
 //
 // Supported input formats:
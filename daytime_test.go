@@ -490,6 +490,33 @@ func TestFromTime(t *testing.T) {
 	}
 }
 
+func TestFromTimeOffset(t *testing.T) {
+	local := time.FixedZone("TestLocal", 3*3600) // UTC+3
+	ref := time.Date(2024, time.February, 15, 12, 0, 0, 0, local)
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		want     Daytime
+		wantDays int
+	}{
+		{"Same date", time.Date(2024, time.February, 15, 8, 30, 0, 0, local), Daytime(8*3600 + 30*60), 0},
+		{"Next midnight is end of day", time.Date(2024, time.February, 16, 0, 0, 0, 0, local), EndOfDay, 0},
+		{"Next date", time.Date(2024, time.February, 16, 0, 0, 1, 0, local), Daytime(1), 1},
+		{"Previous date", time.Date(2024, time.February, 14, 23, 0, 0, 0, local), Daytime(23 * 3600), -1},
+		{"Other location", time.Date(2024, time.February, 15, 22, 0, 0, 0, time.UTC), Daytime(3600), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, days := FromTimeOffset(ref, tt.t)
+			if got != tt.want || days != tt.wantDays {
+				t.Errorf("FromTimeOffset(%v, %v) = (%v, %d), want (%v, %d)", ref, tt.t, got, days, tt.want, tt.wantDays)
+			}
+		})
+	}
+}
+
 func TestParse(t *testing.T) {
 	tests := []struct {
 		name    string
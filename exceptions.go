@@ -0,0 +1,264 @@
+package daytime
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Exception overrides the weekly schedule on a single date,
+// such as a holiday or special opening hours.
+type Exception struct {
+	// Date is the calendar date of the exception; the time of day and location are ignored.
+	Date time.Time
+
+	// Ranges replace the weekly ranges of the date. No ranges means closed all day.
+	// A range spanning midnight extends the opening into the next day,
+	// e.g. 18:00:00-02:00:00 stays open until 02:00:00 of the next date.
+	Ranges []Range
+}
+
+// Closed reports whether the exception closes the whole date.
+func (e Exception) Closed() bool {
+	return len(e.Ranges) == 0
+}
+
+// Schedule combines a weekly schedule with date-specific exceptions.
+//
+// Exceptions are consulted before the weekly template: an exception replaces
+// the ranges opening on its date, while ranges of the previous date spilling
+// over midnight are kept.
+type Schedule struct {
+	weekly     WeeklySchedule
+	exceptions map[dateKey]Exception
+}
+
+// NewSchedule creates a new schedule from a weekly template and exceptions.
+//
+// A later exception for the same date replaces an earlier one.
+func NewSchedule(weekly WeeklySchedule, exceptions ...Exception) *Schedule {
+	s := &Schedule{weekly: weekly, exceptions: make(map[dateKey]Exception)}
+	for _, e := range exceptions {
+		s.SetException(e)
+	}
+	return s
+}
+
+// Weekly returns the weekly template of the schedule.
+func (s *Schedule) Weekly() *WeeklySchedule {
+	return &s.weekly
+}
+
+// SetException adds or replaces the exception for its date.
+func (s *Schedule) SetException(e Exception) {
+	if s.exceptions == nil {
+		s.exceptions = make(map[dateKey]Exception)
+	}
+	e.Ranges = slices.Clone(e.Ranges)
+	s.exceptions[keyOf(e.Date)] = e
+}
+
+// RemoveException removes the exception for the date, if any.
+func (s *Schedule) RemoveException(date time.Time) {
+	delete(s.exceptions, keyOf(date))
+}
+
+// Exception returns the exception for the date, if any.
+func (s *Schedule) Exception(date time.Time) (Exception, bool) {
+	e, ok := s.exceptions[keyOf(date)]
+	return e, ok
+}
+
+// RangesOn returns the ranges opening on the date of t,
+// taken from its exception if present and from the weekly template otherwise.
+func (s *Schedule) RangesOn(t time.Time) []Range {
+	return slices.Clone(s.rangesOn(t))
+}
+
+// IsOpen reports whether the schedule is open at t.
+func (s *Schedule) IsOpen(t time.Time) bool {
+	return isOpen(s.rangesOn, t)
+}
+
+// NextOpen returns the earliest instant at or after t when the schedule is open.
+//
+// Returns t itself if the schedule is already open, and false if it does not open
+// within the following week.
+// A range excluding its start opens one second after it, as in WeeklySchedule.NextOpen.
+func (s *Schedule) NextOpen(t time.Time) (time.Time, bool) {
+	return nextOpen(s.rangesOn, t)
+}
+
+// NextClose returns the earliest instant at or after t when the schedule is closed.
+//
+// Returns t itself if the schedule is already closed, and false if it stays open
+// for the whole following week.
+// A range including its end closes one second after it, as in WeeklySchedule.NextClose.
+func (s *Schedule) NextClose(t time.Time) (time.Time, bool) {
+	return nextClose(s.rangesOn, t)
+}
+
+// NextOpenDaytime returns the next opening as a daytime and the number of days after the date of t,
+// following the same convention as Add.
+//
+// Returns false if the schedule does not open within the following week.
+func (s *Schedule) NextOpenDaytime(t time.Time) (Daytime, int, bool) {
+	next, ok := s.NextOpen(t)
+	if !ok {
+		return 0, 0, false
+	}
+	d, days := FromTimeOffset(t, next)
+	return d, days, true
+}
+
+// NextCloseDaytime returns the next closing as a daytime and the number of days after the date of t,
+// following the same convention as Add. A closing at midnight after the date of t is EndOfDay.
+//
+// Returns false if the schedule stays open for the whole following week.
+func (s *Schedule) NextCloseDaytime(t time.Time) (Daytime, int, bool) {
+	next, ok := s.NextClose(t)
+	if !ok {
+		return 0, 0, false
+	}
+	d, days := FromTimeOffset(t, next)
+	return d, days, true
+}
+
+// OpenDurationBetween returns how long the schedule is open between t1 and t2.
+//
+// Returns zero if t2 is not after t1.
+func (s *Schedule) OpenDurationBetween(t1, t2 time.Time) time.Duration {
+	return openDurationBetween(s.rangesOn, t1, t2)
+}
+
+// rangesOn returns the ranges opening on the given date.
+func (s *Schedule) rangesOn(date time.Time) []Range {
+	if e, ok := s.exceptions[keyOf(date)]; ok {
+		return e.Ranges
+	}
+	return s.weekly.rangesOn(date)
+}
+
+// ReadExceptions reads exceptions from r in either text or JSON form.
+//
+// The text form has one exception per line: a date in YYYY-MM-DD format followed
+// either by the word "closed" or by ranges in ParseRange format separated by spaces.
+// Empty lines and lines starting with '#' are ignored:
+//
+//	# holidays
+//	2025-12-25 closed
+//	2025-12-24 09:00:00-14:00:00
+//	2025-12-31 10:00:00-14:00:00 18:00:00-02:00:00
+//
+// The JSON form is an array of objects with "date" and either "closed": true or non-empty
+// "ranges" fields; unknown fields are rejected:
+//
+//	[{"date": "2025-12-25", "closed": true}, {"date": "2025-12-24", "ranges": ["09:00:00-14:00:00"]}]
+func ReadExceptions(r io.Reader) ([]Exception, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errorf("ReadExceptions", nil, err)
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		return readExceptionsJSON(trimmed)
+	}
+	return readExceptionsText(data)
+}
+
+// --- Helper functions ---
+
+// dateKey identifies a calendar date regardless of location.
+type dateKey struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// keyOf returns the calendar date of t.
+func keyOf(t time.Time) dateKey {
+	year, month, day := t.Date()
+	return dateKey{year: year, month: month, day: day}
+}
+
+// readExceptionsText reads exceptions in the line-oriented text form.
+func readExceptionsText(data []byte) ([]Exception, error) {
+	var exceptions []Exception
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, errorf("ReadExceptions", fmt.Sprintf("line %d: %s", n, line), ErrInvalidFormat)
+		}
+		date, err := time.Parse(time.DateOnly, fields[0])
+		if err != nil {
+			return nil, errorf("ReadExceptions", fmt.Sprintf("line %d: %s", n, line), ErrInvalidFormat)
+		}
+
+		e := Exception{Date: date}
+		if len(fields) == 2 && strings.EqualFold(fields[1], "closed") {
+			exceptions = append(exceptions, e)
+			continue
+		}
+		for _, field := range fields[1:] {
+			r, err := ParseRange(field)
+			if err != nil {
+				return nil, errorf("ReadExceptions", fmt.Sprintf("line %d: %s", n, line), ErrInvalidFormat)
+			}
+			e.Ranges = append(e.Ranges, r)
+		}
+		exceptions = append(exceptions, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errorf("ReadExceptions", nil, err)
+	}
+	return exceptions, nil
+}
+
+// readExceptionsJSON reads exceptions in the JSON form.
+func readExceptionsJSON(data []byte) ([]Exception, error) {
+	var entries []struct {
+		Date   string   `json:"date"`
+		Closed bool     `json:"closed"`
+		Ranges []string `json:"ranges"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&entries); err != nil {
+		return nil, errorf("ReadExceptions", nil, ErrInvalidFormat)
+	}
+	if dec.More() {
+		return nil, errorf("ReadExceptions", nil, ErrInvalidFormat)
+	}
+
+	exceptions := make([]Exception, 0, len(entries))
+	for _, entry := range entries {
+		date, err := time.Parse(time.DateOnly, entry.Date)
+		if err != nil {
+			return nil, errorf("ReadExceptions", entry.Date, ErrInvalidFormat)
+		}
+		if entry.Closed == (len(entry.Ranges) > 0) {
+			return nil, errorf("ReadExceptions", entry.Date, ErrInvalidFormat)
+		}
+
+		e := Exception{Date: date}
+		for _, s := range entry.Ranges {
+			r, err := ParseRange(s)
+			if err != nil {
+				return nil, errorf("ReadExceptions", s, ErrInvalidFormat)
+			}
+			e.Ranges = append(e.Ranges, r)
+		}
+		exceptions = append(exceptions, e)
+	}
+	return exceptions, nil
+}
@@ -0,0 +1,183 @@
+package daytime
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSchedule_Exceptions(t *testing.T) {
+	s := NewSchedule(*testSchedule(t),
+		// Monday closed all day
+		Exception{Date: testDate(6, 0, 0)},
+		// Tuesday short hours
+		Exception{Date: testDate(7, 0, 0), Ranges: []Range{MustRange(D120000, Must(14, 0, 0), ClosedOpen)}},
+		// Wednesday extended until 02:00 next day
+		Exception{Date: testDate(8, 0, 0), Ranges: []Range{MustRange(Must(9, 0, 0), Must(2, 0, 0), ClosedOpen)}},
+	)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{"Closed Monday", testDate(6, 12, 0), false},
+		{"Short Tuesday: before", testDate(7, 10, 0), false},
+		{"Short Tuesday: during", testDate(7, 13, 0), true},
+		{"Extended Wednesday: evening", testDate(8, 23, 0), true},
+		{"Extended Wednesday: spill into Thursday", testDate(9, 1, 0), true},
+		{"Regular Thursday", testDate(9, 8, 0), false},
+		{"Friday night spill kept on Saturday", testDate(11, 1, 0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.IsOpen(tt.t); got != tt.want {
+				t.Errorf("IsOpen(%s) got %t, want %t", tt.t.Format(time.DateTime), got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Lookup and removal", func(t *testing.T) {
+		e, ok := s.Exception(testDate(6, 15, 0))
+		if !ok || !e.Closed() {
+			t.Errorf("Exception(Monday) got (%v, %t), want closed exception", e, ok)
+		}
+		s.RemoveException(testDate(6, 0, 0))
+		if _, ok := s.Exception(testDate(6, 0, 0)); ok {
+			t.Errorf("Exception(Monday) after removal got ok, want false")
+		}
+		want := []Range{MustRange(Must(9, 0, 0), D180000, ClosedOpen)}
+		if got := s.RangesOn(testDate(6, 0, 0)); !slices.Equal(got, want) {
+			t.Errorf("RangesOn(Monday) after removal got %v, want %v", got, want)
+		}
+		s.SetException(Exception{Date: testDate(6, 0, 0)})
+	})
+}
+
+func TestSchedule_NextDaytime(t *testing.T) {
+	s := NewSchedule(*testSchedule(t),
+		Exception{Date: testDate(6, 0, 0)},
+		Exception{Date: testDate(8, 0, 0), Ranges: []Range{MustRange(Must(9, 0, 0), Must(2, 0, 0), ClosedOpen)}},
+	)
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		open     bool
+		wantD    Daytime
+		wantDays int
+	}{
+		{"Next open skips closed Monday", testDate(6, 8, 0), true, Must(9, 0, 0), 1},
+		{"Next close of extended Wednesday", testDate(8, 20, 0), false, Must(2, 0, 0), 1},
+		{"Next close at midnight is EndOfDay", testDate(11, 20, 0), false, D240000, 0},
+		{"Next open from Saturday night", testDate(11, 20, 0), true, Must(20, 0, 0), 0},
+		{"Next open from Sunday", testDate(12, 8, 0), true, Must(9, 0, 0), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				d    Daytime
+				days int
+				ok   bool
+			)
+			if tt.open {
+				d, days, ok = s.NextOpenDaytime(tt.t)
+			} else {
+				d, days, ok = s.NextCloseDaytime(tt.t)
+			}
+			if !ok || d != tt.wantD || days != tt.wantDays {
+				t.Errorf("got (%s, %d, %t), want (%s, %d, true)", d, days, ok, tt.wantD, tt.wantDays)
+			}
+		})
+	}
+
+	t.Run("Open duration honors exceptions", func(t *testing.T) {
+		got := s.OpenDurationBetween(testDate(6, 0, 0), testDate(8, 0, 0))
+		if got != 9*time.Hour {
+			t.Errorf("OpenDurationBetween(Monday, Wednesday) got %v, want 9h", got)
+		}
+	})
+}
+
+func TestReadExceptions(t *testing.T) {
+	christmasEve := time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC)
+	christmas := time.Date(2025, time.December, 25, 0, 0, 0, 0, time.UTC)
+	newYearsEve := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	want := []Exception{
+		{Date: christmas},
+		{Date: christmasEve, Ranges: []Range{MustRange(Must(9, 0, 0), Must(14, 0, 0), ClosedOpen)}},
+		{Date: newYearsEve, Ranges: []Range{
+			MustRange(Must(10, 0, 0), Must(14, 0, 0), ClosedOpen),
+			MustRange(D180000, Must(2, 0, 0), ClosedOpen),
+		}},
+	}
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"Text", `
+# holidays
+2025-12-25 closed
+2025-12-24 09:00:00-14:00:00
+
+2025-12-31 10:00:00-14:00:00 18:00:00-02:00:00
+`},
+		{"JSON", `[
+	{"date": "2025-12-25", "closed": true},
+	{"date": "2025-12-24", "ranges": ["09:00:00-14:00:00"]},
+	{"date": "2025-12-31", "ranges": ["10:00:00-14:00:00", "18:00:00-02:00:00"]}
+]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadExceptions(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("ReadExceptions() got unexpected error: %v", err)
+			}
+			if !slices.EqualFunc(got, want, func(a, b Exception) bool {
+				return a.Date.Equal(b.Date) && slices.Equal(a.Ranges, b.Ranges)
+			}) {
+				t.Errorf("ReadExceptions() got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestReadExceptions_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"Text: Missing ranges", "2025-12-25"},
+		{"Text: Invalid date", "2025-13-25 closed"},
+		{"Text: Invalid range", "2025-12-25 09:00:00"},
+		{"JSON: Malformed", `[{"date": }]`},
+		{"JSON: Invalid date", `[{"date": "25.12.2025"}]`},
+		{"JSON: Neither closed nor ranges", `[{"date": "2025-12-25"}]`},
+		{"JSON: Empty ranges", `[{"date": "2025-12-25", "ranges": []}]`},
+		{"JSON: Not closed", `[{"date": "2025-12-25", "closed": false}]`},
+		{"JSON: Unknown field", `[{"date": "2025-12-24", "range": ["09:00:00-14:00:00"]}]`},
+		{"JSON: Trailing data", `[{"date": "2025-12-25", "closed": true}] []`},
+		{"JSON: Closed with ranges", `[{"date": "2025-12-25", "closed": true, "ranges": ["09:00:00-14:00:00"]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadExceptions(strings.NewReader(tt.input))
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Fatalf("ReadExceptions(%q) got error %v, want %v", tt.input, err, ErrInvalidFormat)
+			}
+			var daytimeErr *Error
+			if !errors.As(err, &daytimeErr) || daytimeErr.Operation() != "ReadExceptions" {
+				t.Errorf("ReadExceptions(%q) got error %v, want operation ReadExceptions", tt.input, err)
+			}
+		})
+	}
+}
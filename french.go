@@ -0,0 +1,103 @@
+package daytime
+
+// French is the French language.
+//
+// It reads phrases such as "midi", "minuit", "fin de journée", "neuf heures et quart",
+// "dix heures moins le quart" and "sept heures et demie du soir", and spells out
+// "neuf heures vingt du matin", "neuf heures vingt" and "vingt et une heures".
+// It must not be modified.
+var French = &Language{
+	Numbers: frenchNumbers(),
+	Times: map[string]Daytime{
+		"midi":              Daytime(12 * 3600),
+		"minuit":            StartOfDay,
+		"fin de journée":    EndOfDay,
+		"fin de la journée": EndOfDay,
+	},
+	Fractions: map[string]int{"quart": 15, "demie": 30, "demi": 30},
+	Past:      []string{"et"},
+	To:        []string{"moins"},
+	HourFirst: true,
+	OClock:    []string{"pile"},
+	Periods: map[string]Range{
+		"du matin":        {start: StartOfDay, end: Daytime(12 * 3600)},
+		"de l'après-midi": {start: Daytime(12 * 3600), end: Daytime(18 * 3600)},
+		"du soir":         {start: Daytime(16 * 3600), end: EndOfDay},
+		"de la nuit":      {start: Daytime(18 * 3600), end: Daytime(6 * 3600)},
+	},
+	Fillers: []string{"à", "h", "heure", "heures", "le", "minute", "minutes"},
+
+	Cardinal:           frenchCardinal,
+	Hour:               frenchHour,
+	Minutes:            frenchMinutes,
+	MidnightName:       "minuit",
+	NoonName:           "midi",
+	EndOfDayName:       "fin de journée",
+	OClockFormat:       "{hour}",
+	PastFormat:         "{hour} {minutes}",
+	ToFormat:           "{hour} moins {minutes}",
+	ClockFormat:        "{hour} {minutes}",
+	MilitaryFormat:     "{hour} {minutes}",
+	MilitaryHourFormat: "{hour}",
+	DayParts: []DayPart{
+		{Name: "du matin", Range: Range{start: StartOfDay, end: Daytime(12 * 3600)}},
+		{Name: "de l'après-midi", Range: Range{start: Daytime(12 * 3600), end: Daytime(18 * 3600)}},
+		{Name: "du soir", Range: Range{start: Daytime(18 * 3600), end: EndOfDay}},
+	},
+}
+
+// --- Helper functions ---
+
+var (
+	frenchOnes = []string{
+		"zéro", "une", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix",
+		"onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf",
+	}
+	frenchTens = []string{"", "", "vingt", "trente", "quarante", "cinquante"}
+)
+
+// frenchCardinal spells out a number from 0 to 59 in French, in the feminine
+// agreeing with "heure" and "minute".
+func frenchCardinal(n int) string {
+	switch {
+	case n < 20:
+		return frenchOnes[n]
+	case n%10 == 0:
+		return frenchTens[n/10]
+	case n%10 == 1:
+		return frenchTens[n/10] + " et une"
+	default:
+		return frenchTens[n/10] + "-" + frenchOnes[n%10]
+	}
+}
+
+// frenchNumbers returns the French number words from 0 to 59, including the masculine "un".
+func frenchNumbers() map[string]int {
+	numbers := map[string]int{"un": 1}
+	for n := range 60 {
+		numbers[frenchCardinal(n)] = n
+	}
+	return numbers
+}
+
+// frenchHour spells out an hour in French followed by "heure" or "heures".
+func frenchHour(h int) string {
+	if h < 2 {
+		return frenchCardinal(h) + " heure"
+	}
+	return frenchCardinal(h) + " heures"
+}
+
+// frenchMinutes spells out conversational minutes in French.
+func frenchMinutes(m int, to bool) string {
+	switch {
+	case m == 15 && to:
+		return "le quart"
+	case m == 15:
+		return "et quart"
+	case m == 30:
+		return "et demie"
+	default:
+		return frenchCardinal(m)
+	}
+}
@@ -0,0 +1,78 @@
+package daytime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFrench_Parse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Daytime
+	}{
+		{"midi", D120000},
+		{"Minuit", D000000},
+		{"fin de journée", D240000},
+		{"à neuf heures", Must(9, 0, 0)},
+		{"neuf heures vingt", Must(9, 20, 0)},
+		{"neuf heures et quart", Must(9, 15, 0)},
+		{"dix heures moins le quart", Must(9, 45, 0)},
+		{"sept heures et demie du soir", Must(19, 30, 0)},
+		{"une heure de l'après-midi", Must(13, 0, 0)},
+		{"vingt et une heures trente et une", Must(21, 31, 0)},
+		{"dix-sept heures pile", Must(17, 0, 0)},
+		{"midi et quart", Must(12, 15, 0)},
+		{"minuit moins cinq", Must(23, 55, 0)},
+		{"minuit vingt", Must(0, 20, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := French.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) got unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) got %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"midi et", "minuit soixante", "treize heures du matin"} {
+		if _, err := French.Parse(input); err == nil {
+			t.Errorf("Parse(%q) got no error", input)
+		} else if !errors.Is(err, ErrInvalidFormat) && !errors.Is(err, ErrInvalidTimeComponent) {
+			t.Errorf("Parse(%q) got error %v, want a parse error", input, err)
+		}
+	}
+}
+
+func TestFrench_Words(t *testing.T) {
+	tests := []struct {
+		d     Daytime
+		style WordStyle
+		want  string
+	}{
+		{D000000, Conversational, "minuit"},
+		{D240000, Conversational, "fin de journée"},
+		{D120000, Conversational, "midi"},
+		{Must(12, 15, 0), Conversational, "midi et quart"},
+		{Must(23, 45, 0), Conversational, "minuit moins le quart"},
+		{Must(1, 0, 0), Conversational, "une heure du matin"},
+		{Must(9, 20, 0), Conversational, "neuf heures vingt du matin"},
+		{Must(13, 30, 0), Conversational, "une heure et demie de l'après-midi"},
+		{Must(20, 45, 0), Conversational, "neuf heures moins le quart du soir"},
+		{Must(9, 20, 0), Digital, "neuf heures vingt"},
+		{Must(21, 0, 0), Digital, "neuf heures"},
+		{Must(21, 0, 0), Military, "vingt et une heures"},
+		{Must(21, 31, 0), Military, "vingt et une heures trente et une"},
+		{D000000, Military, "zéro heure"},
+		{D240000, Military, "vingt-quatre heures"},
+	}
+
+	for _, tt := range tests {
+		if got := French.Words(tt.d, tt.style); got != tt.want {
+			t.Errorf("Words(%s, %d) got %q, want %q", tt.d, tt.style, got, tt.want)
+		}
+	}
+}
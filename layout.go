@@ -0,0 +1,300 @@
+package daytime
+
+import (
+	"strconv"
+	"strings"
+)
+
+// layoutToken identifies a time-of-day element of a layout string.
+type layoutToken int
+
+const (
+	tokenNone       layoutToken = iota
+	tokenHour                   // "15"
+	tokenHour12                 // "3"
+	tokenZeroHour12             // "03"
+	tokenMinute                 // "4"
+	tokenZeroMinute             // "04"
+	tokenSecond                 // "5"
+	tokenZeroSecond             // "05"
+	tokenPM                     // "PM"
+	tokenLowerPM                // "pm"
+	tokenFracZeros              // ".000", fixed number of digits
+	tokenFracNines              // ".999", trailing zeros omitted
+)
+
+// nextLayoutToken splits the layout at the first time-of-day token.
+//
+// It returns the literal text before the token, the token, its length for fractional
+// tokens (including the separator), and the remaining layout.
+func nextLayoutToken(layout string) (prefix string, token layoutToken, width int, suffix string) {
+	for i := 0; i < len(layout); i++ {
+		rest := layout[i:]
+		switch {
+		case strings.HasPrefix(rest, "15"):
+			return layout[:i], tokenHour, 2, layout[i+2:]
+		case strings.HasPrefix(rest, "03"):
+			return layout[:i], tokenZeroHour12, 2, layout[i+2:]
+		case strings.HasPrefix(rest, "04"):
+			return layout[:i], tokenZeroMinute, 2, layout[i+2:]
+		case strings.HasPrefix(rest, "05"):
+			return layout[:i], tokenZeroSecond, 2, layout[i+2:]
+		case rest[0] == '3':
+			return layout[:i], tokenHour12, 1, layout[i+1:]
+		case rest[0] == '4':
+			return layout[:i], tokenMinute, 1, layout[i+1:]
+		case rest[0] == '5':
+			return layout[:i], tokenSecond, 1, layout[i+1:]
+		case strings.HasPrefix(rest, "PM"):
+			return layout[:i], tokenPM, 2, layout[i+2:]
+		case strings.HasPrefix(rest, "pm"):
+			return layout[:i], tokenLowerPM, 2, layout[i+2:]
+		case (rest[0] == '.' || rest[0] == ',') && len(rest) > 1 && (rest[1] == '0' || rest[1] == '9'):
+			digit := rest[1]
+			j := 1
+			for j < len(rest) && rest[j] == digit {
+				j++
+			}
+			// Like the time package, a fraction must not be followed by another digit.
+			if j < len(rest) && rest[j] >= '0' && rest[j] <= '9' {
+				continue
+			}
+			token := tokenFracZeros
+			if digit == '9' {
+				token = tokenFracNines
+			}
+			return layout[:i], token, j, layout[i+j:]
+		}
+	}
+	return layout, tokenNone, 0, ""
+}
+
+// FormatLayout formats the daytime according to the layout without a base date.
+//
+// The layout uses the time-of-day reference values of the time package:
+// "15" (24-hour clock), "3" and "03" (12-hour clock), "4" and "04" (minute),
+// "5" and "05" (second), "PM" and "pm", and fractional seconds ".000" or ".999"
+// (always zero, since a Daytime has whole seconds). Other text is copied literally,
+// including date reference values, which have no meaning for a daytime.
+//
+// Unlike Format, EndOfDay renders as 24:00:00 with the 24-hour clock,
+// e.g. "15:04" yields "24:00"; with the 12-hour clock it renders as midnight ("12:00 AM").
+// Returns "invalid" for invalid daytimes.
+func (d Daytime) FormatLayout(layout string) string {
+	return string(d.AppendFormat(make([]byte, 0, len(layout)+8), layout))
+}
+
+// AppendFormat is like FormatLayout but appends the textual representation to b
+// and returns the extended buffer.
+func (d Daytime) AppendFormat(b []byte, layout string) []byte {
+	if !d.Valid() {
+		return append(b, "invalid"...)
+	}
+
+	hour, minute, second := d.Clock()
+	hour12 := hour % 12
+	if hour12 == 0 {
+		hour12 = 12
+	}
+	pm := hour >= 12 && hour < hoursInDay
+
+	for layout != "" {
+		prefix, token, width, suffix := nextLayoutToken(layout)
+		b = append(b, prefix...)
+		switch token {
+		case tokenHour:
+			b = appendInt(b, hour, 2)
+		case tokenHour12:
+			b = appendInt(b, hour12, 1)
+		case tokenZeroHour12:
+			b = appendInt(b, hour12, 2)
+		case tokenMinute:
+			b = appendInt(b, minute, 1)
+		case tokenZeroMinute:
+			b = appendInt(b, minute, 2)
+		case tokenSecond:
+			b = appendInt(b, second, 1)
+		case tokenZeroSecond:
+			b = appendInt(b, second, 2)
+		case tokenPM:
+			b = append(b, meridiemText(pm, "AM", "PM")...)
+		case tokenLowerPM:
+			b = append(b, meridiemText(pm, "am", "pm")...)
+		case tokenFracZeros:
+			b = append(b, layout[len(prefix)])
+			for range width - 1 {
+				b = append(b, '0')
+			}
+		case tokenFracNines:
+			// Whole seconds have no fraction to print.
+		}
+		layout = suffix
+	}
+	return b
+}
+
+// ParseLayout parses a daytime formatted according to the layout without a base date.
+//
+// The layout uses the same elements as FormatLayout. Hours, minutes and seconds
+// missing from the layout are zero, "PM"/"pm" accept either case, and fractional
+// seconds are truncated. With the 24-hour clock "24" parses as EndOfDay when
+// minutes and seconds are zero.
+//
+// Errors wrap a *ParseError reporting the offset of the problem and are errors.Is-compatible
+// with ErrInvalidFormat, ErrInvalidTimeComponent and ErrEndOfDayExceeded.
+func ParseLayout(layout, s string) (Daytime, error) {
+	d, err := parseLayout(layout, s)
+	if err != nil {
+		return 0, errorf("ParseLayout", s, err)
+	}
+	return d, nil
+}
+
+// --- Helper functions ---
+
+// parseLayout implements ParseLayout.
+func parseLayout(layout, s string) (Daytime, error) {
+	fail := func(offset int, err error, msg string) error {
+		return &ParseError{Input: s, Offset: offset, Msg: msg, Err: err}
+	}
+
+	var (
+		hour, minute, second int
+		hourPos, minutePos   int
+		secondPos            int
+		hour12, hasPM, pm    bool
+	)
+	pos := 0
+	for layout != "" {
+		prefix, token, width, suffix := nextLayoutToken(layout)
+		if !strings.HasPrefix(s[pos:], prefix) {
+			return 0, fail(pos, ErrInvalidFormat, "expected "+strconv.Quote(prefix))
+		}
+		pos += len(prefix)
+
+		var err error
+		switch token {
+		case tokenHour, tokenHour12, tokenMinute, tokenSecond:
+			start := pos
+			var value int
+			if value, pos, err = readNumber(s, pos, 1, 2); err != nil {
+				return 0, fail(start, ErrInvalidFormat, "expected number")
+			}
+			switch token {
+			case tokenHour:
+				hour, hourPos = value, start
+			case tokenHour12:
+				hour, hourPos, hour12 = value, start, true
+			case tokenMinute:
+				minute, minutePos = value, start
+			case tokenSecond:
+				second, secondPos = value, start
+			}
+		case tokenZeroHour12, tokenZeroMinute, tokenZeroSecond:
+			start := pos
+			var value int
+			if value, pos, err = readNumber(s, pos, 2, 2); err != nil {
+				return 0, fail(start, ErrInvalidFormat, "expected two digits")
+			}
+			switch token {
+			case tokenZeroHour12:
+				hour, hourPos, hour12 = value, start, true
+			case tokenZeroMinute:
+				minute, minutePos = value, start
+			case tokenZeroSecond:
+				second, secondPos = value, start
+			}
+		case tokenPM, tokenLowerPM:
+			if len(s)-pos < 2 {
+				return 0, fail(pos, ErrInvalidFormat, "expected AM or PM")
+			}
+			switch strings.ToUpper(s[pos : pos+2]) {
+			case "AM":
+				hasPM, pm = true, false
+			case "PM":
+				hasPM, pm = true, true
+			default:
+				return 0, fail(pos, ErrInvalidFormat, "expected AM or PM")
+			}
+			pos += 2
+		case tokenFracZeros:
+			if len(s)-pos < width || s[pos] != layout[len(prefix)] {
+				return 0, fail(pos, ErrInvalidFormat, "expected fractional second")
+			}
+			if _, end, err := readNumber(s, pos+1, width-1, width-1); err != nil || end != pos+width {
+				return 0, fail(pos, ErrInvalidFormat, "expected fractional second")
+			}
+			pos += width
+		case tokenFracNines:
+			if pos < len(s) && s[pos] == layout[len(prefix)] {
+				start := pos
+				if _, pos, err = readNumber(s, pos+1, 1, 9); err != nil {
+					return 0, fail(start, ErrInvalidFormat, "expected fractional second")
+				}
+			}
+		}
+		layout = suffix
+	}
+	if pos != len(s) {
+		return 0, fail(pos, ErrInvalidFormat, "unexpected text")
+	}
+
+	if minute > 59 {
+		return 0, fail(minutePos, ErrInvalidTimeComponent, "minute out of range")
+	}
+	if second > 59 {
+		return 0, fail(secondPos, ErrInvalidTimeComponent, "second out of range")
+	}
+	if hour12 || hasPM {
+		if hour12 && (hour < 1 || hour > 12) {
+			return 0, fail(hourPos, ErrInvalidTimeComponent, "12-hour clock hour must be in [1, 12]")
+		}
+		if hour > 12 {
+			return 0, fail(hourPos, ErrInvalidTimeComponent, "hour does not match AM/PM")
+		}
+		if hour == 12 {
+			hour = 0
+		}
+		if pm {
+			hour += 12
+		}
+	}
+	if hour > hoursInDay {
+		return 0, fail(hourPos, ErrInvalidTimeComponent, "hour out of range")
+	}
+	if hour == hoursInDay && (minute != 0 || second != 0) {
+		return 0, fail(hourPos, ErrEndOfDayExceeded, "24:00:00 must have zero minutes and seconds")
+	}
+	return Daytime(hour*3600 + minute*60 + second), nil
+}
+
+// readNumber reads between minDigits and maxDigits ASCII digits of s starting at pos.
+func readNumber(s string, pos, minDigits, maxDigits int) (value, end int, err error) {
+	end = pos
+	for end < len(s) && end-pos < maxDigits && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	if end-pos < minDigits {
+		return 0, pos, ErrInvalidFormat
+	}
+	return atoi(s[pos:end]), end, nil
+}
+
+// appendInt appends a non-negative integer padded with zeros to width digits.
+func appendInt(b []byte, n, width int) []byte {
+	if n < 10 && width == 2 {
+		b = append(b, '0')
+	}
+	if n >= 10 {
+		b = append(b, byte('0'+n/10))
+	}
+	return append(b, byte('0'+n%10))
+}
+
+// meridiemText returns the AM or PM text.
+func meridiemText(pm bool, am, pmText string) string {
+	if pm {
+		return pmText
+	}
+	return am
+}
@@ -0,0 +1,146 @@
+package daytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDaytime_FormatLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		d      Daytime
+		layout string
+		want   string
+	}{
+		{"TimeOnly", D123045, time.TimeOnly, "12:30:45"},
+		{"Kitchen PM", D123045, time.Kitchen, "12:30PM"},
+		{"Kitchen AM", D010000, time.Kitchen, "1:00AM"},
+		{"Zero-padded 12-hour", Must(9, 5, 7), "03:04:05 pm", "09:05:07 am"},
+		{"Unpadded components", Must(9, 5, 7), "3:4:5", "9:5:7"},
+		{"Fraction zeros", D123045, "15:04:05.000", "12:30:45.000"},
+		{"Fraction nines omitted", D123045, "15:04:05.999", "12:30:45"},
+		{"Comma fraction", D123045, "15:04:05,00", "12:30:45,00"},
+		{"Literal text", D180000, "at 15h04", "at 18h00"},
+		{"StartOfDay 24-hour", D000000, "15:04", "00:00"},
+		{"EndOfDay 24-hour", D240000, "15:04", "24:00"},
+		{"EndOfDay 12-hour", D240000, "3:04 PM", "12:00 AM"},
+		{"Noon 12-hour", D120000, "3 PM", "12 PM"},
+		{"Invalid daytime", DInvalid, "15:04", "invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.FormatLayout(tt.layout); got != tt.want {
+				t.Errorf("FormatLayout(%q) = %q, want %q", tt.layout, got, tt.want)
+			}
+			if got := string(tt.d.AppendFormat([]byte(">"), tt.layout)); got != ">"+tt.want {
+				t.Errorf("AppendFormat(%q) = %q, want %q", tt.layout, got, ">"+tt.want)
+			}
+		})
+	}
+}
+
+func TestDaytime_FormatLayout_MatchesFormat(t *testing.T) {
+	base := time.Date(2025, time.October, 26, 0, 0, 0, 0, time.UTC)
+	layouts := []string{time.TimeOnly, time.Kitchen, "03:04:05 PM", "15.04.05.000", "3:4:5 pm"}
+
+	for _, d := range []Daytime{D000000, D010000, D123045, D180000, D235959} {
+		for _, layout := range layouts {
+			if got, want := d.FormatLayout(layout), d.Format(layout, base); got != want {
+				t.Errorf("%s.FormatLayout(%q) = %q, Format = %q", d, layout, got, want)
+			}
+		}
+	}
+}
+
+func TestDaytime_AppendFormat_Allocations(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = D123045.AppendFormat(buf[:0], time.TimeOnly)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFormat allocated %v times, want 0", allocs)
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout string
+		input  string
+		want   Daytime
+	}{
+		{"TimeOnly", time.TimeOnly, "12:30:45", D123045},
+		{"Kitchen", time.Kitchen, "6:00PM", D180000},
+		{"Lowercase meridiem in input", time.Kitchen, "6:00pm", D180000},
+		{"Midnight 12-hour", "3:04 PM", "12:00 AM", StartOfDay},
+		{"Single-digit 24-hour", "15:04", "9:30", Must(9, 30, 0)},
+		{"EndOfDay", "15:04", "24:00", EndOfDay},
+		{"Fraction truncated", "15:04:05.999", "12:30:45.75", D123045},
+		{"Optional fraction absent", "15:04:05.999", "12:30:45", D123045},
+		{"Fixed fraction", "15:04:05.000", "12:30:45.123", D123045},
+		{"Missing components are zero", "15h", "18h", D180000},
+		{"Literal text", "at 15h04", "at 18h00", D180000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLayout(tt.layout, tt.input)
+			if err != nil {
+				t.Fatalf("ParseLayout(%q, %q) got unexpected error: %v", tt.layout, tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLayout(%q, %q) got %s, want %s", tt.layout, tt.input, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Round trip", func(t *testing.T) {
+		for _, layout := range []string{time.TimeOnly, "03:04:05 PM", "15:04"} {
+			for _, d := range []Daytime{D000000, D010000, D120000, D180000, D240000} {
+				if layout == "03:04:05 PM" && d == D240000 {
+					continue // renders as midnight
+				}
+				s := d.FormatLayout(layout)
+				if got, err := ParseLayout(layout, s); err != nil || got != d {
+					t.Errorf("ParseLayout(%q, %q) got (%s, %v), want %s", layout, s, got, err, d)
+				}
+			}
+		}
+	})
+}
+
+func TestParseLayout_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout string
+		input  string
+		err    error
+		offset int
+	}{
+		{"Literal mismatch", "15:04", "12-30", ErrInvalidFormat, 2},
+		{"Missing digits", "15:04", "12:", ErrInvalidFormat, 3},
+		{"Padded minute needs two digits", "15:04", "12:3", ErrInvalidFormat, 3},
+		{"Trailing text", "15:04", "12:30:00", ErrInvalidFormat, 5},
+		{"Bad meridiem", time.Kitchen, "6:00XM", ErrInvalidFormat, 4},
+		{"Fixed fraction too short", "15:04:05.000", "12:30:45.1", ErrInvalidFormat, 8},
+		{"Minute out of range", "15:04", "12:60", ErrInvalidTimeComponent, 3},
+		{"Hour out of range", "15:04", "25:00", ErrInvalidTimeComponent, 0},
+		{"12-hour clock zero", time.Kitchen, "0:30PM", ErrInvalidTimeComponent, 0},
+		{"EndOfDay exceeded", "15:04", "24:30", ErrEndOfDayExceeded, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseLayout(tt.layout, tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseLayout(%q, %q) got error %v, want %v", tt.layout, tt.input, err, tt.err)
+			}
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || parseErr.Offset != tt.offset {
+				t.Errorf("ParseLayout(%q, %q) got error %v, want offset %d", tt.layout, tt.input, err, tt.offset)
+			}
+		})
+	}
+}
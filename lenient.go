@@ -0,0 +1,276 @@
+package daytime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError describes a problem found at a specific position of the input while parsing.
+//
+// It is wrapped in an *Error, and unwraps to one of ErrInvalidFormat,
+// ErrInvalidTimeComponent or ErrEndOfDayExceeded.
+type ParseError struct {
+	// Input is the string being parsed.
+	Input string
+
+	// Offset is the byte offset in Input where the problem was found.
+	Offset int
+
+	// Msg describes the problem.
+	Msg string
+
+	// Err is the sentinel error for the problem.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v at offset %d: %s", e.Err, e.Offset, e.Msg)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseLenient parses a daytime from the forms people commonly type.
+//
+// Supported input formats (letters are case-insensitive, surrounding spaces are ignored):
+//
+//   - "H:MM" and "H:MM:SS" with one or two hour digits (e.g., "9:05", "21:30:00")
+//   - "HMM", "HHMM" and "HHMMSS" without separators (e.g., "930", "2130", "213000")
+//   - 12-hour clock with "am"/"pm", "a.m."/"p.m." or "a"/"p" suffix (e.g., "9am", "9:30 PM")
+//   - ISO 8601 time with optional "T" prefix, fractional seconds and zone designator
+//     (e.g., "T21:30:00Z", "21:30:00.5+02:00"); the fraction is truncated and the zone
+//     designator is validated but ignored, since a Daytime carries no location
+//   - a bare hour (e.g., "21")
+//
+// "24:00" and "24:00:00" parse as EndOfDay. Unlike Parse, integer seconds are not accepted,
+// because "2130" reads as 21:30.
+//
+// Errors wrap a *ParseError reporting the offset of the problem and are errors.Is-compatible
+// with ErrInvalidFormat, ErrInvalidTimeComponent and ErrEndOfDayExceeded.
+func ParseLenient(s string) (Daytime, error) {
+	p := lenientParser{input: s}
+	d, err := p.parse()
+	if err != nil {
+		return 0, errorf("ParseLenient", s, err)
+	}
+	return d, nil
+}
+
+// lenientParser scans the input of ParseLenient left to right.
+type lenientParser struct {
+	input string
+	pos   int
+}
+
+// parse parses the whole input.
+func (p *lenientParser) parse() (Daytime, error) {
+	p.skipSpaces()
+	if p.pos == len(p.input) {
+		return 0, p.fail(p.pos, ErrInvalidFormat, "empty input")
+	}
+	if c := p.peek(); c == 'T' || c == 't' {
+		p.pos++
+	}
+
+	hourPos := p.pos
+	digits := p.digits()
+	if digits == "" {
+		return 0, p.fail(p.pos, ErrInvalidFormat, "expected hour")
+	}
+
+	var hour, minute, second int
+	minutePos, secondPos := -1, -1
+	if p.peek() == ':' {
+		if len(digits) > 2 {
+			return 0, p.fail(hourPos, ErrInvalidFormat, "hour must have one or two digits")
+		}
+		hour = atoi(digits)
+
+		p.pos++
+		minutePos = p.pos
+		var err error
+		if minute, err = p.twoDigits("minute"); err != nil {
+			return 0, err
+		}
+		if p.peek() == ':' {
+			p.pos++
+			secondPos = p.pos
+			if second, err = p.twoDigits("second"); err != nil {
+				return 0, err
+			}
+			if err := p.fraction(); err != nil {
+				return 0, err
+			}
+		}
+	} else {
+		switch len(digits) {
+		case 1, 2:
+			hour = atoi(digits)
+		case 3, 4:
+			hour, minute = atoi(digits[:len(digits)-2]), atoi(digits[len(digits)-2:])
+			minutePos = hourPos + len(digits) - 2
+		case 6:
+			hour, minute, second = atoi(digits[:2]), atoi(digits[2:4]), atoi(digits[4:])
+			minutePos, secondPos = hourPos+2, hourPos+4
+		default:
+			return 0, p.fail(hourPos, ErrInvalidFormat, "expected HMM, HHMM or HHMMSS")
+		}
+		if secondPos >= 0 {
+			if err := p.fraction(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	p.skipSpaces()
+	meridiem := p.meridiem()
+	if meridiem == 0 {
+		if err := p.zone(); err != nil {
+			return 0, err
+		}
+	}
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return 0, p.fail(p.pos, ErrInvalidFormat, "unexpected text")
+	}
+
+	if minute > 59 {
+		return 0, p.fail(minutePos, ErrInvalidTimeComponent, "minute out of range")
+	}
+	if second > 59 {
+		return 0, p.fail(secondPos, ErrInvalidTimeComponent, "second out of range")
+	}
+
+	if meridiem != 0 {
+		if hour < 1 || hour > 12 {
+			return 0, p.fail(hourPos, ErrInvalidTimeComponent, "12-hour clock hour must be in [1, 12]")
+		}
+		if hour == 12 {
+			hour = 0
+		}
+		if meridiem == 'p' {
+			hour += 12
+		}
+		return Daytime(hour*3600 + minute*60 + second), nil
+	}
+
+	if hour > hoursInDay {
+		return 0, p.fail(hourPos, ErrInvalidTimeComponent, "hour out of range")
+	}
+	if hour == hoursInDay && (minute != 0 || second != 0) {
+		return 0, p.fail(minutePos, ErrEndOfDayExceeded, "24:00:00 must have zero minutes and seconds")
+	}
+	return Daytime(hour*3600 + minute*60 + second), nil
+}
+
+// fail creates a parse error at the given offset.
+func (p *lenientParser) fail(offset int, err error, msg string) error {
+	return &ParseError{Input: p.input, Offset: offset, Msg: msg, Err: err}
+}
+
+// peek returns the current byte, or 0 at the end of input.
+func (p *lenientParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+// skipSpaces advances past spaces and tabs.
+func (p *lenientParser) skipSpaces() {
+	for p.peek() == ' ' || p.peek() == '\t' {
+		p.pos++
+	}
+}
+
+// digits consumes and returns a run of ASCII digits.
+func (p *lenientParser) digits() string {
+	start := p.pos
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// twoDigits consumes exactly two digits of the named component.
+func (p *lenientParser) twoDigits(name string) (int, error) {
+	start := p.pos
+	for range 2 {
+		if c := p.peek(); c < '0' || c > '9' {
+			return 0, p.fail(start, ErrInvalidFormat, name+" must have two digits")
+		}
+		p.pos++
+	}
+	return atoi(p.input[start:p.pos]), nil
+}
+
+// fraction consumes an optional fractional second, which is truncated.
+func (p *lenientParser) fraction() error {
+	if c := p.peek(); c != '.' && c != ',' {
+		return nil
+	}
+	p.pos++
+	if p.digits() == "" {
+		return p.fail(p.pos, ErrInvalidFormat, "expected fractional second digits")
+	}
+	return nil
+}
+
+// meridiem consumes an optional 12-hour clock suffix and returns 'a', 'p', or 0 if absent.
+func (p *lenientParser) meridiem() byte {
+	rest := strings.ToLower(p.input[p.pos:])
+	for _, suffix := range []string{"a.m.", "p.m.", "am", "pm", "a", "p"} {
+		if strings.HasPrefix(rest, suffix) {
+			p.pos += len(suffix)
+			return suffix[0]
+		}
+	}
+	return 0
+}
+
+// zone consumes an optional ISO 8601 zone designator: "Z", "±HH", "±HHMM" or "±HH:MM".
+func (p *lenientParser) zone() error {
+	switch p.peek() {
+	case 'Z', 'z':
+		p.pos++
+		return nil
+	case '+', '-':
+	default:
+		return nil
+	}
+
+	p.pos++
+	start := p.pos
+	hours, err := p.twoDigits("zone hour")
+	if err != nil {
+		return err
+	}
+	if hours > 23 {
+		return p.fail(start, ErrInvalidTimeComponent, "zone hour out of range")
+	}
+
+	if p.peek() == ':' {
+		p.pos++
+	} else if c := p.peek(); c < '0' || c > '9' {
+		return nil
+	}
+	start = p.pos
+	minutes, err := p.twoDigits("zone minute")
+	if err != nil {
+		return err
+	}
+	if minutes > 59 {
+		return p.fail(start, ErrInvalidTimeComponent, "zone minute out of range")
+	}
+	return nil
+}
+
+// atoi converts a short string of ASCII digits to int.
+func atoi(digits string) int {
+	n := 0
+	for i := 0; i < len(digits); i++ {
+		n = n*10 + int(digits[i]-'0')
+	}
+	return n
+}
@@ -0,0 +1,107 @@
+package daytime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseLenient(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Daytime
+	}{
+		{"Strict form", "21:30:00", Must(21, 30, 0)},
+		{"Single-digit hour with seconds", "9:05:00", Must(9, 5, 0)},
+		{"Hours and minutes", "12:30", Must(12, 30, 0)},
+		{"Single-digit hour and minutes", "9:05", Must(9, 5, 0)},
+		{"Bare hour", "21", Must(21, 0, 0)},
+		{"Compact HMM", "930", Must(9, 30, 0)},
+		{"Compact HHMM", "2130", Must(21, 30, 0)},
+		{"Compact HHMMSS", "213015", Must(21, 30, 15)},
+		{"AM suffix", "9am", Must(9, 0, 0)},
+		{"PM suffix with space", "9:30 PM", Must(21, 30, 0)},
+		{"Dotted suffix", "9:30 p.m.", Must(21, 30, 0)},
+		{"Single letter suffix", "7p", Must(19, 0, 0)},
+		{"Midnight in 12-hour clock", "12am", StartOfDay},
+		{"Noon in 12-hour clock", "12:00 pm", Must(12, 0, 0)},
+		{"ISO with T and Z", "T21:30:00Z", Must(21, 30, 0)},
+		{"ISO with fraction and offset", "21:30:00.5+02:00", Must(21, 30, 0)},
+		{"ISO basic with offset", "T213000-0500", Must(21, 30, 0)},
+		{"Surrounding spaces", "  21:30  ", Must(21, 30, 0)},
+		{"EndOfDay", "24:00", EndOfDay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLenient(tt.input)
+			if err != nil {
+				t.Fatalf("ParseLenient(%q) got unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLenient(%q) got %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLenient_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		err    error
+		offset int
+	}{
+		{"Empty", "", ErrInvalidFormat, 0},
+		{"No digits", "noon", ErrInvalidFormat, 0},
+		{"Three-digit hour", "123:00", ErrInvalidFormat, 0},
+		{"One-digit minute", "9:5", ErrInvalidFormat, 2},
+		{"Five digits", "21300", ErrInvalidFormat, 0},
+		{"Trailing text", "9:30 tomorrow", ErrInvalidFormat, 5},
+		{"Missing fraction digits", "21:30:00.", ErrInvalidFormat, 9},
+		{"Minute out of range", "9:75", ErrInvalidTimeComponent, 2},
+		{"Second out of range", "21:30:61", ErrInvalidTimeComponent, 6},
+		{"Hour out of range", "25:00", ErrInvalidTimeComponent, 0},
+		{"Compact minute out of range", "T2175", ErrInvalidTimeComponent, 3},
+		{"13 PM", "13pm", ErrInvalidTimeComponent, 0},
+		{"0 AM", " 0:30 am", ErrInvalidTimeComponent, 1},
+		{"Zone hour out of range", "21:30+25:00", ErrInvalidTimeComponent, 6},
+		{"24:30", "24:30", ErrEndOfDayExceeded, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLenient(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseLenient(%q) got error %v, want %v", tt.input, err, tt.err)
+			}
+			if got != 0 {
+				t.Errorf("ParseLenient(%q) on error got %s, want 0", tt.input, got)
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseLenient(%q) got error %v, want *ParseError", tt.input, err)
+			}
+			if parseErr.Offset != tt.offset {
+				t.Errorf("ParseLenient(%q) got offset %d, want %d (%v)", tt.input, parseErr.Offset, tt.offset, err)
+			}
+
+			var daytimeErr *Error
+			if !errors.As(err, &daytimeErr) || daytimeErr.Operation() != "ParseLenient" || daytimeErr.Value() != tt.input {
+				t.Errorf("ParseLenient(%q) got error %v, want *Error for ParseLenient", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestParse_StaysStrict(t *testing.T) {
+	for _, input := range []string{"9:05:00", "12:30", "9am", "T21:30:00Z"} {
+		if _, err := Parse(input); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Parse(%q) got error %v, want %v", input, err, ErrInvalidFormat)
+		}
+	}
+	if got, err := Parse("2130"); err != nil || got != Daytime(2130) {
+		t.Errorf("Parse(2130) got (%s, %v), want integer seconds", got, err)
+	}
+}
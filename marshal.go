@@ -0,0 +1,81 @@
+package daytime
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// The daytime is encoded in HH:MM:SS format, with EndOfDay encoded as "24:00:00".
+// Returns ErrValueOutOfRange for invalid daytimes.
+func (d Daytime) MarshalText() ([]byte, error) {
+	if !d.Valid() {
+		return nil, errorf("MarshalText", uint32(d), ErrValueOutOfRange)
+	}
+	return []byte(d.String()), nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+//
+// It appends the same encoding as MarshalText to b.
+func (d Daytime) AppendText(b []byte) ([]byte, error) {
+	if !d.Valid() {
+		return b, errorf("AppendText", uint32(d), ErrValueOutOfRange)
+	}
+	return append(b, d.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// Accepts any format supported by Parse.
+func (d *Daytime) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return errorf("UnmarshalText", string(text), errors.Unwrap(err))
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The daytime is encoded as a JSON string in HH:MM:SS format.
+func (d Daytime) MarshalJSON() ([]byte, error) {
+	if !d.Valid() {
+		return nil, errorf("MarshalJSON", uint32(d), ErrValueOutOfRange)
+	}
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// Accepts either a JSON string in any format supported by Parse (e.g., "09:00:00")
+// or a JSON integer of seconds since midnight (e.g., 32400).
+// A JSON null leaves the daytime unchanged.
+func (d *Daytime) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return errorf("UnmarshalJSON", s, ErrInvalidFormat)
+		}
+		parsed, err := Parse(text)
+		if err != nil {
+			return errorf("UnmarshalJSON", text, errors.Unwrap(err))
+		}
+		*d = parsed
+		return nil
+	}
+
+	sec, err := parseSeconds(s)
+	if err != nil {
+		return errorf("UnmarshalJSON", s, errors.Unwrap(err))
+	}
+	*d = Daytime(sec)
+	return nil
+}
@@ -0,0 +1,131 @@
+package daytime
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler   = Daytime(0)
+	_ encoding.TextUnmarshaler = (*Daytime)(nil)
+	_ json.Marshaler           = Daytime(0)
+	_ json.Unmarshaler         = (*Daytime)(nil)
+)
+
+func TestDaytime_MarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Daytime
+		want string
+		err  error
+	}{
+		{"StartOfDay", D000000, "00:00:00", nil},
+		{"Mid-day", D123045, "12:30:45", nil},
+		{"EndOfDay", D240000, "24:00:00", nil},
+		{"Invalid daytime", DInvalid, "", ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.MarshalText()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("MarshalText() got error %v, want %v", err, tt.err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalText() got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaytime_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Daytime
+		err   error
+	}{
+		{"Time format", "12:30:45", D123045, nil},
+		{"Seconds format", "3600", D010000, nil},
+		{"EndOfDay", "24:00:00", D240000, nil},
+		{"Error: Invalid format", "noon", 0, ErrInvalidFormat},
+		{"Error: Empty", "", 0, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Daytime
+			err := got.UnmarshalText([]byte(tt.input))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("UnmarshalText(%q) got error %v, want %v", tt.input, err, tt.err)
+			}
+			if tt.err != nil {
+				var daytimeErr *Error
+				if !errors.As(err, &daytimeErr) || daytimeErr.Operation() != "UnmarshalText" {
+					t.Errorf("UnmarshalText(%q) got error %v, want *Error with operation UnmarshalText", tt.input, err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText(%q) got %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaytime_JSON(t *testing.T) {
+	type hours struct {
+		Opens  Daytime  `json:"opens"`
+		Closes Daytime  `json:"closes"`
+		Break  *Daytime `json:"break,omitempty"`
+	}
+
+	t.Run("Marshal", func(t *testing.T) {
+		got, err := json.Marshal(hours{Opens: Must(9, 0, 0), Closes: D240000})
+		if err != nil {
+			t.Fatalf("json.Marshal() got unexpected error: %v", err)
+		}
+		want := `{"opens":"09:00:00","closes":"24:00:00"}`
+		if string(got) != want {
+			t.Errorf("json.Marshal() got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("Marshal invalid", func(t *testing.T) {
+		_, err := json.Marshal(hours{Opens: DInvalid})
+		if !errors.Is(err, ErrValueOutOfRange) {
+			t.Errorf("json.Marshal() got error %v, want %v", err, ErrValueOutOfRange)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		input string
+		want  hours
+		err   error
+	}{
+		{"String form", `{"opens":"09:00:00","closes":"18:00:00"}`, hours{Opens: Must(9, 0, 0), Closes: D180000}, nil},
+		{"Integer seconds form", `{"opens":32400,"closes":86400}`, hours{Opens: Must(9, 0, 0), Closes: D240000}, nil},
+		{"Seconds in string form", `{"opens":"32400"}`, hours{Opens: Must(9, 0, 0)}, nil},
+		{"Null leaves value unchanged", `{"opens":null}`, hours{}, nil},
+		{"Error: Invalid string", `{"opens":"9am"}`, hours{}, ErrInvalidFormat},
+		{"Error: Out of range seconds", `{"opens":86401}`, hours{}, ErrValueOutOfRange},
+		{"Error: Fractional seconds", `{"opens":1.5}`, hours{}, ErrInvalidFormat},
+		{"Error: Boolean", `{"opens":true}`, hours{}, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run("Unmarshal "+tt.name, func(t *testing.T) {
+			var got hours
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("json.Unmarshal(%s) got error %v, want %v", tt.input, err, tt.err)
+			}
+			if tt.err == nil && got != tt.want {
+				t.Errorf("json.Unmarshal(%s) got %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,502 @@
+package daytime
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Language holds the word tables used to parse times of day written out as phrases
+// and to spell them out with Words.
+//
+// Phrases in the parsing tables may consist of several words. They are matched
+// case-insensitively and word by word, where hyphens and commas separate words and periods
+// are dropped, so "a.m." in a table matches "AM", "am" and "a.m." in the input.
+// A new language is added by filling in a Language; English and French are predefined.
+type Language struct {
+	// Numbers maps number words and phrases to their values, e.g. "nine": 9.
+	// A multiple of ten from 20 followed by a word from 1 to 9 adds up,
+	// so "twenty five" reads as 25 even without an entry. Digits are always accepted.
+	Numbers map[string]int
+
+	// Times maps phrases naming a fixed time of day to it, e.g. "noon": 12:00:00.
+	// They stand alone or serve as the hour after Past and To words.
+	Times map[string]Daytime
+
+	// Fractions maps words naming a part of an hour to minutes, e.g. "quarter": 15.
+	Fractions map[string]int
+
+	// Past and To are the words adding minutes to an hour or taking them from it,
+	// e.g. "past" and "to".
+	Past, To []string
+
+	// HourFirst reports whether the hour comes before Past and To words,
+	// as in Spanish "nueve y cuarto", rather than after them, as in "quarter past nine".
+	HourFirst bool
+
+	// OClock are the words that may follow a full hour, e.g. "o'clock".
+	OClock []string
+
+	// Periods maps phrases naming a part of the day to the daytimes it covers,
+	// e.g. "in the evening": 16:00:00-24:00:00. A period following a clock reading
+	// selects between its morning and afternoon hour on the 12-hour clock.
+	Periods map[string]Range
+
+	// Fillers are words ignored between the parts of a phrase, e.g. "at" and "minutes".
+	Fillers []string
+
+	// Cardinal spells out a number from 0 to 59 for Words, e.g. 25 as "twenty-five".
+	Cardinal func(n int) string
+
+	// Hour spells out an hour as it stands in phrases, e.g. French 1 as "une heure".
+	// If nil, Cardinal is used.
+	Hour func(hour int) string
+
+	// Minutes spells out the minutes past an hour, or to it if to is true, in the
+	// Conversational style, e.g. 15 as "quarter". If nil, Cardinal is used.
+	Minutes func(minutes int, to bool) string
+
+	// ClockMinutes spells out minutes as read off a clock in the Digital and Military styles,
+	// e.g. 5 as "oh five". If nil, Cardinal is used.
+	ClockMinutes func(minutes int) string
+
+	// MilitaryHour spells out an hour from 0 to 24 in the Military style,
+	// e.g. 9 as "oh nine". If nil, Hour is used.
+	MilitaryHour func(hour int) string
+
+	// MidnightName, NoonName and EndOfDayName name StartOfDay, 12:00:00 and EndOfDay in Words,
+	// e.g. "midnight", "noon" and "end of day".
+	MidnightName, NoonName, EndOfDayName string
+
+	// OClockFormat, PastFormat, ToFormat, ClockFormat, MilitaryFormat and MilitaryHourFormat
+	// arrange the spelled-out "{hour}" and "{minutes}" in Words: a full hour, minutes past
+	// and to an hour, a clock reading with minutes, and military readings with and without
+	// minutes, e.g. "{hour} o'clock", "{minutes} past {hour}", "{minutes} to {hour}",
+	// "{hour} {minutes}", "{hour} {minutes} hours" and "{hour} hundred hours".
+	OClockFormat, PastFormat, ToFormat, ClockFormat, MilitaryFormat, MilitaryHourFormat string
+
+	// DayParts name the parts of the day appended to Conversational readings;
+	// the first part containing the daytime is used.
+	DayParts []DayPart
+}
+
+// English is the English language.
+//
+// It reads phrases such as "noon", "midnight", "end of day", "half past 7",
+// "quarter to ten pm", "twenty-five to six", "nine thirty" and "7 in the evening",
+// and spells out "twenty past nine in the morning", "nine twenty" and "twenty-one hundred hours".
+// It must not be modified.
+var English = &Language{
+	Numbers: map[string]int{
+		"zero": 0, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+		"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+		"thirteen": 13, "fourteen": 14, "fifteen": 15, "sixteen": 16, "seventeen": 17,
+		"eighteen": 18, "nineteen": 19, "twenty": 20, "thirty": 30, "forty": 40, "fifty": 50,
+	},
+	Times: map[string]Daytime{
+		"noon":           Daytime(12 * 3600),
+		"midday":         Daytime(12 * 3600),
+		"midnight":       StartOfDay,
+		"end of day":     EndOfDay,
+		"end of the day": EndOfDay,
+	},
+	Fractions: map[string]int{"quarter": 15, "half": 30},
+	Past:      []string{"past", "after"},
+	To:        []string{"to", "before", "till"},
+	OClock:    []string{"o'clock", "oclock"},
+	Periods: map[string]Range{
+		"am":               {start: StartOfDay, end: Daytime(12 * 3600)},
+		"pm":               {start: Daytime(12 * 3600), end: EndOfDay},
+		"in the morning":   {start: StartOfDay, end: Daytime(12 * 3600)},
+		"in the afternoon": {start: Daytime(12 * 3600), end: Daytime(18 * 3600)},
+		"in the evening":   {start: Daytime(16 * 3600), end: EndOfDay},
+		"at night":         {start: Daytime(18 * 3600), end: Daytime(6 * 3600)},
+	},
+	Fillers: []string{"at", "a", "the", "minute", "minutes"},
+
+	Cardinal:           englishCardinal,
+	Minutes:            englishMinutes,
+	ClockMinutes:       englishClockMinutes,
+	MilitaryHour:       englishMilitaryHour,
+	MidnightName:       "midnight",
+	NoonName:           "noon",
+	EndOfDayName:       "end of day",
+	OClockFormat:       "{hour} o'clock",
+	PastFormat:         "{minutes} past {hour}",
+	ToFormat:           "{minutes} to {hour}",
+	ClockFormat:        "{hour} {minutes}",
+	MilitaryFormat:     "{hour} {minutes} hours",
+	MilitaryHourFormat: "{hour} hundred hours",
+	DayParts: []DayPart{
+		{Name: "in the morning", Range: Range{start: StartOfDay, end: Daytime(12 * 3600)}},
+		{Name: "in the afternoon", Range: Range{start: Daytime(12 * 3600), end: Daytime(18 * 3600)}},
+		{Name: "in the evening", Range: Range{start: Daytime(18 * 3600), end: EndOfDay}},
+	},
+}
+
+// ParseNatural parses a time of day written as an English phrase.
+//
+// It is shorthand for English.Parse; see Language.Parse for the accepted phrases.
+func ParseNatural(s string) (Daytime, error) {
+	return English.Parse(s)
+}
+
+// Parse parses a time of day written as a phrase in the language.
+//
+// Accepted phrases, shown in English (letters are case-insensitive, fillers are ignored):
+//
+//   - a fixed time such as "noon", "midnight" or "end of day", the latter being EndOfDay
+//   - an hour, optionally followed by o'clock (e.g., "seven", "7 o'clock", "19")
+//   - an hour followed by minutes (e.g., "nine thirty")
+//   - minutes or a fraction past or to an hour or a fixed time
+//     (e.g., "half past 7", "twenty-five to six", "a quarter to midnight")
+//
+// Any of the clock readings may end with a period of the day, which turns the hour into
+// a 12-hour clock hour from 1 to 12 (e.g., "quarter to ten pm", "7 in the evening").
+// The period picks the morning or afternoon hour it contains before minutes are applied,
+// so "ten to twelve pm" is 11:50:00 and "ten to twelve am" is 23:50:00; if it contains
+// neither, it picks the reading it contains (e.g., "quarter to six in the afternoon").
+// Without a period, hours are read on the 24-hour clock.
+//
+// Errors wrap a *ParseError reporting the offset of the problem and are errors.Is-compatible
+// with ErrInvalidFormat and ErrInvalidTimeComponent.
+func (l *Language) Parse(s string) (Daytime, error) {
+	p := naturalParser{lang: l, input: s, words: splitWords(s)}
+	d, err := p.parse()
+	if err != nil {
+		return 0, errorf("ParseNatural", s, err)
+	}
+	return d, nil
+}
+
+// --- Helper functions ---
+
+// word is a normalized word of a phrase and its byte offset in the input.
+type word struct {
+	text   string
+	offset int
+}
+
+// splitWords splits s into lowercase words, dropping periods.
+func splitWords(s string) []word {
+	var words []word
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		text := strings.ToLower(strings.ReplaceAll(s[start:end], ".", ""))
+		text = strings.ReplaceAll(text, "’", "'")
+		if text != "" {
+			words = append(words, word{text: text, offset: start})
+		}
+		start = -1
+	}
+	for i, r := range s {
+		if unicode.IsSpace(r) || r == '-' || r == ',' {
+			flush(i)
+		} else if start < 0 {
+			start = i
+		}
+	}
+	flush(len(s))
+	return words
+}
+
+// naturalParser reads the words of a phrase left to right.
+type naturalParser struct {
+	lang  *Language
+	input string
+	words []word
+	pos   int
+}
+
+// parse parses the whole phrase.
+func (p *naturalParser) parse() (Daytime, error) {
+	p.skipFillers()
+	if p.pos == len(p.words) {
+		return 0, p.fail(ErrInvalidFormat, "empty input")
+	}
+	if d, ok := p.time(); ok {
+		if p.lang.HourFirst {
+			return p.fixedTime(d)
+		}
+		return d, p.end()
+	}
+
+	firstOffset := p.offset()
+	first, isFraction, ok := p.amount()
+	if !ok {
+		return 0, p.fail(ErrInvalidFormat, "expected a time of day")
+	}
+
+	var hour, minutes, direction int
+	hourOffset, minutesOffset := firstOffset, -1
+	fixed := false
+	if direction = p.direction(); direction != 0 {
+		p.skipFillers()
+		secondOffset := p.offset()
+		if p.lang.HourFirst {
+			if isFraction {
+				return 0, p.failAt(firstOffset, ErrInvalidFormat, "expected hour")
+			}
+			second, _, ok := p.amount()
+			if !ok {
+				return 0, p.fail(ErrInvalidFormat, "expected minutes")
+			}
+			hour, minutes, minutesOffset = first, second, secondOffset
+		} else {
+			minutes, minutesOffset, hourOffset = first, firstOffset, secondOffset
+			if d, ok := p.time(); ok {
+				hour, fixed = int(d)/3600, true
+			} else if hour, isFraction, ok = p.amount(); !ok || isFraction {
+				return 0, p.failAt(secondOffset, ErrInvalidFormat, "expected hour")
+			}
+		}
+	} else {
+		if isFraction {
+			return 0, p.failAt(firstOffset, ErrInvalidFormat, "expected hour")
+		}
+		hour = first
+		minutesOffset = p.offset()
+		if n, ok := p.number(); ok {
+			minutes, direction = n, 1
+		} else {
+			minutesOffset = -1
+			p.accept(p.lang.OClock)
+		}
+	}
+
+	if minutes > 59 {
+		return 0, p.failAt(minutesOffset, ErrInvalidTimeComponent, "minute out of range")
+	}
+
+	periodOffset := p.offset()
+	period, hasPeriod := p.period()
+	if err := p.end(); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case hasPeriod && fixed:
+		return 0, p.failAt(periodOffset, ErrInvalidFormat, "unexpected period of the day")
+	case hasPeriod:
+		if hour < 1 || hour > 12 {
+			return 0, p.failAt(hourOffset, ErrInvalidTimeComponent, "12-hour clock hour must be in [1, 12]")
+		}
+		// The period selects the hour, so "quarter to twelve pm" is 11:45:00. Failing that,
+		// it must contain the result, so "quarter to six in the afternoon" is 17:45:00.
+		hours := []int{hour % 12, hour%12 + 12}
+		for _, h := range hours {
+			if period.Contains(Daytime(h * 3600)) {
+				return clockMinutes(h*60 + direction*minutes), nil
+			}
+		}
+		for _, h := range hours {
+			if d := clockMinutes(h*60 + direction*minutes); period.Contains(d) {
+				return d, nil
+			}
+		}
+		return 0, p.failAt(periodOffset, ErrInvalidTimeComponent, "time is outside the period of the day")
+	case hour > hoursInDay-1 && !fixed:
+		return 0, p.failAt(hourOffset, ErrInvalidTimeComponent, "hour out of range")
+	default:
+		return clockMinutes(hour*60 + direction*minutes), nil
+	}
+}
+
+// fixedTime parses the minutes that may follow a fixed time in languages with the hour first,
+// as in French "midi et quart" or "minuit vingt".
+func (p *naturalParser) fixedTime(d Daytime) (Daytime, error) {
+	direction := p.direction()
+	p.skipFillers()
+	offset := p.offset()
+	var minutes int
+	var ok bool
+	if direction != 0 {
+		if minutes, _, ok = p.amount(); !ok {
+			return 0, p.fail(ErrInvalidFormat, "expected minutes")
+		}
+	} else if minutes, ok = p.number(); ok {
+		direction = 1
+	}
+	if minutes > 59 {
+		return 0, p.failAt(offset, ErrInvalidTimeComponent, "minute out of range")
+	}
+	if err := p.end(); err != nil {
+		return 0, err
+	}
+	if direction == 0 {
+		return d, nil
+	}
+	return clockMinutes(int(d)/60 + direction*minutes), nil
+}
+
+// fail creates a parse error at the current word.
+func (p *naturalParser) fail(err error, msg string) error {
+	return p.failAt(p.offset(), err, msg)
+}
+
+// failAt creates a parse error at the given offset.
+func (p *naturalParser) failAt(offset int, err error, msg string) error {
+	return &ParseError{Input: p.input, Offset: offset, Msg: msg, Err: err}
+}
+
+// offset returns the offset of the current word, or the length of the input at the end.
+func (p *naturalParser) offset() int {
+	if p.pos < len(p.words) {
+		return p.words[p.pos].offset
+	}
+	return len(p.input)
+}
+
+// end skips trailing fillers and reports unexpected words.
+func (p *naturalParser) end() error {
+	p.skipFillers()
+	if p.pos != len(p.words) {
+		return p.fail(ErrInvalidFormat, "unexpected word")
+	}
+	return nil
+}
+
+// skipFillers advances past filler words.
+func (p *naturalParser) skipFillers() {
+	for {
+		n := p.longestMatch(p.lang.Fillers)
+		if n == 0 {
+			return
+		}
+		p.pos += n
+	}
+}
+
+// time consumes a phrase naming a fixed time of day.
+func (p *naturalParser) time() (Daytime, bool) {
+	phrase, ok := p.acceptPhrase(keys(p.lang.Times))
+	return p.lang.Times[phrase], ok
+}
+
+// period consumes a phrase naming a part of the day.
+func (p *naturalParser) period() (Range, bool) {
+	phrase, ok := p.acceptPhrase(keys(p.lang.Periods))
+	return p.lang.Periods[phrase], ok
+}
+
+// direction consumes a Past or To word and returns 1 or -1, or 0 if there is none.
+func (p *naturalParser) direction() int {
+	if p.accept(p.lang.Past) {
+		return 1
+	}
+	if p.accept(p.lang.To) {
+		return -1
+	}
+	return 0
+}
+
+// amount consumes a number or a fraction of an hour, reporting which of the two it was.
+func (p *naturalParser) amount() (n int, isFraction, ok bool) {
+	if n, ok := p.number(); ok {
+		return n, false, true
+	}
+	phrase, ok := p.acceptPhrase(keys(p.lang.Fractions))
+	return p.lang.Fractions[phrase], true, ok
+}
+
+// number consumes a number given in digits or words.
+func (p *naturalParser) number() (int, bool) {
+	start := p.pos
+	p.skipFillers()
+	if p.pos == len(p.words) {
+		p.pos = start
+		return 0, false
+	}
+
+	text := p.words[p.pos].text
+	if len(text) <= 2 && strings.Trim(text, "0123456789") == "" {
+		p.pos++
+		return atoi(text), true
+	}
+	phrase, length := p.longest(keys(p.lang.Numbers))
+	if length == 0 {
+		p.pos = start
+		return 0, false
+	}
+	n := p.lang.Numbers[phrase]
+	p.pos += length
+
+	if n >= 20 && n%10 == 0 && p.pos < len(p.words) {
+		if units, ok := p.lang.Numbers[p.words[p.pos].text]; ok && units >= 1 && units <= 9 {
+			n += units
+			p.pos++
+		}
+	}
+	return n, true
+}
+
+// accept consumes the longest of the phrases found at the current word, skipping fillers
+// before it if none is found right away.
+func (p *naturalParser) accept(phrases []string) bool {
+	_, ok := p.acceptPhrase(phrases)
+	return ok
+}
+
+// acceptPhrase is like accept and returns the phrase consumed.
+func (p *naturalParser) acceptPhrase(phrases []string) (string, bool) {
+	start := p.pos
+	phrase, n := p.longest(phrases)
+	if n == 0 {
+		p.skipFillers()
+		phrase, n = p.longest(phrases)
+	}
+	if n == 0 {
+		p.pos = start
+		return "", false
+	}
+	p.pos += n
+	return phrase, true
+}
+
+// longestMatch returns the number of words of the longest phrase found at the current word, or 0.
+func (p *naturalParser) longestMatch(phrases []string) int {
+	_, n := p.longest(phrases)
+	return n
+}
+
+// longest returns the longest phrase found at the current word and its number of words.
+func (p *naturalParser) longest(phrases []string) (string, int) {
+	best, length := "", 0
+	for _, phrase := range phrases {
+		if n := p.matchAt(phrase); n > length {
+			best, length = phrase, n
+		}
+	}
+	return best, length
+}
+
+// matchAt returns the number of words of the phrase found at the current word, or 0.
+func (p *naturalParser) matchAt(phrase string) int {
+	want := splitWords(phrase)
+	if len(want) == 0 || len(want) > len(p.words)-p.pos {
+		return 0
+	}
+	for i, w := range want {
+		if p.words[p.pos+i].text != w.text {
+			return 0
+		}
+	}
+	return len(want)
+}
+
+// keys returns the phrases of a word table.
+func keys[V any](table map[string]V) []string {
+	phrases := make([]string, 0, len(table))
+	for phrase := range table {
+		phrases = append(phrases, phrase)
+	}
+	return phrases
+}
+
+// clockMinutes returns the daytime at the given minutes after midnight, wrapping around the day.
+func clockMinutes(minutes int) Daytime {
+	const minutesInDay = secondsInDay / 60
+	return Daytime((minutes%minutesInDay + minutesInDay) % minutesInDay * 60)
+}
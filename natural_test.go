@@ -0,0 +1,152 @@
+package daytime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseNatural(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Daytime
+	}{
+		{"Noon", "noon", D120000},
+		{"Midnight", "Midnight", D000000},
+		{"End of day", "end of day", D240000},
+		{"End of the day with filler", "at the end of the day", D240000},
+		{"Bare hour", "seven", Must(7, 0, 0)},
+		{"Hour in digits", "19", Must(19, 0, 0)},
+		{"O'clock", "six o’clock", Must(6, 0, 0)},
+		{"Hour and minutes", "nine thirty", Must(9, 30, 0)},
+		{"Hour and compound minutes", "nine twenty five", Must(9, 25, 0)},
+		{"Compound hour", "twenty-three", D230000},
+		{"Half past", "half past 7", Must(7, 30, 0)},
+		{"Quarter past", "a quarter past nine", Must(9, 15, 0)},
+		{"Quarter to", "quarter to ten", Must(9, 45, 0)},
+		{"Quarter to PM", "quarter to ten pm", Must(21, 45, 0)},
+		{"Dotted PM", "Quarter to Ten P.M.", Must(21, 45, 0)},
+		{"Minutes to", "twenty-five minutes to six", Must(5, 35, 0)},
+		{"Minutes past in digits", "10 past 11", Must(11, 10, 0)},
+		{"To midnight", "a quarter to midnight", Must(23, 45, 0)},
+		{"Past noon", "half past noon", Must(12, 30, 0)},
+		{"Evening", "7 in the evening", Must(19, 0, 0)},
+		{"Morning", "half past seven in the morning", Must(7, 30, 0)},
+		{"Afternoon", "at three in the afternoon", Must(15, 0, 0)},
+		{"Late night", "eleven at night", D230000},
+		{"Early night", "2 at night", Must(2, 0, 0)},
+		{"Twelve AM", "twelve am", D000000},
+		{"Twelve PM", "12 pm", D120000},
+		{"Quarter to one AM", "quarter to one am", Must(0, 45, 0)},
+		{"Quarter to twelve AM", "quarter to twelve am", Must(23, 45, 0)},
+		{"Quarter to twelve PM", "quarter to twelve pm", Must(11, 45, 0)},
+		{"Ten to twelve PM", "ten to twelve pm", Must(11, 50, 0)},
+		{"Half past twelve PM", "half past twelve pm", Must(12, 30, 0)},
+		{"Half past twelve AM", "half past twelve am", Must(0, 30, 0)},
+		{"To the hour after the period", "quarter to six in the afternoon", Must(17, 45, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNatural(tt.input)
+			if err != nil {
+				t.Fatalf("ParseNatural(%q) got unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseNatural(%q) got %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseNatural_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		err    error
+		offset int
+	}{
+		{"Empty", "  ", ErrInvalidFormat, 2},
+		{"Unknown word", "teatime", ErrInvalidFormat, 0},
+		{"Trailing text", "noon tomorrow", ErrInvalidFormat, 5},
+		{"Fraction without hour", "half", ErrInvalidFormat, 0},
+		{"Missing hour", "quarter past", ErrInvalidFormat, 12},
+		{"Fraction as hour", "quarter past half", ErrInvalidFormat, 13},
+		{"Hour out of range", "half past 25", ErrInvalidTimeComponent, 10},
+		{"Minute out of range", "nine 75", ErrInvalidTimeComponent, 5},
+		{"13 PM", "thirteen pm", ErrInvalidTimeComponent, 0},
+		{"Outside period", "nine in the afternoon", ErrInvalidTimeComponent, 5},
+		{"Period after fixed time", "quarter to midnight pm", ErrInvalidFormat, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNatural(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseNatural(%q) got error %v, want %v", tt.input, err, tt.err)
+			}
+			if got != 0 {
+				t.Errorf("ParseNatural(%q) on error got %s, want 0", tt.input, got)
+			}
+
+			var e *Error
+			if !errors.As(err, &e) || e.Operation() != "ParseNatural" || e.Value() != tt.input {
+				t.Errorf("ParseNatural(%q) got error %#v, want an *Error for ParseNatural", tt.input, err)
+			}
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("ParseNatural(%q) got error %v, want a *ParseError", tt.input, err)
+			}
+			if pe.Offset != tt.offset {
+				t.Errorf("ParseNatural(%q) got offset %d, want %d", tt.input, pe.Offset, tt.offset)
+			}
+		})
+	}
+}
+
+func TestLanguage_Parse(t *testing.T) {
+	// A custom language with the hour before the minutes.
+	spanish := &Language{
+		Numbers: map[string]int{
+			"una": 1, "dos": 2, "siete": 7, "nueve": 9, "diez": 10, "veinte": 20, "veinticinco": 25,
+		},
+		Times:     map[string]Daytime{"mediodía": D120000, "medianoche": D000000},
+		Fractions: map[string]int{"cuarto": 15, "media": 30},
+		Past:      []string{"y"},
+		To:        []string{"menos"},
+		HourFirst: true,
+		OClock:    []string{"en punto"},
+		Periods: map[string]Range{
+			"de la mañana": MustRange(D000000, D120000, ClosedOpen),
+			"de la tarde":  MustRange(D120000, Must(21, 0, 0), ClosedOpen),
+		},
+		Fillers: []string{"a", "las", "la"},
+	}
+
+	tests := []struct {
+		input string
+		want  Daytime
+	}{
+		{"mediodía", D120000},
+		{"a las nueve y cuarto", Must(9, 15, 0)},
+		{"las diez menos cuarto", Must(9, 45, 0)},
+		{"las siete y media de la tarde", Must(19, 30, 0)},
+		{"la una y veinticinco", Must(1, 25, 0)},
+		{"las dos en punto de la tarde", Must(14, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		got, err := spanish.Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) got unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) got %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := spanish.Parse("cuarto y nueve"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Parse(%q) got error %v, want %v", "cuarto y nueve", err, ErrInvalidFormat)
+	}
+}
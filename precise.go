@@ -0,0 +1,319 @@
+package daytime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Precise represents a time moment within a day with nanosecond resolution,
+// stored as nanoseconds since midnight [0, 86400000000000].
+//
+// It follows the same rules as Daytime: the zero value is the start of day (00:00:00)
+// and PreciseEndOfDay represents the end of day (24:00:00).
+type Precise int64
+
+const (
+	nanosInSecond = int64(time.Second)
+	nanosInDay    = secondsInDay * nanosInSecond
+
+	// PreciseStartOfDay represents the start of day (00:00:00)
+	PreciseStartOfDay = Precise(0)
+
+	// PreciseEndOfDay represents the end of day (24:00:00)
+	PreciseEndOfDay = Precise(nanosInDay)
+)
+
+// Rounding selects how a Precise value is converted to whole seconds.
+type Rounding uint8
+
+const (
+	// RoundDown drops the fractional second, like FromTime does.
+	RoundDown Rounding = iota
+
+	// RoundNearest rounds to the nearest second, with halves rounded up.
+	RoundNearest
+
+	// RoundUp rounds any fractional second up to the next second.
+	RoundUp
+)
+
+// Valid checks if the precise daytime represents a valid time value [PreciseStartOfDay, PreciseEndOfDay].
+func (p Precise) Valid() bool {
+	return p >= 0 && p <= PreciseEndOfDay
+}
+
+// IsEndOfDay checks if the precise daytime represents the end of day (24:00:00).
+func (p Precise) IsEndOfDay() bool {
+	return p == PreciseEndOfDay
+}
+
+// --- Creation ---
+
+// NewPrecise creates a new precise daytime from hours, minutes, seconds, and nanoseconds.
+//
+// Valid ranges:
+//
+//   - hour: [0, 24]
+//   - minute: [0, 59]
+//   - second: [0, 59]
+//   - nsec: [0, 999999999]
+//
+// Returns an error if any component is out of range or if 24:00:00
+// is specified with non-zero minutes, seconds, or nanoseconds.
+func NewPrecise(hour, minute, second, nsec int) (Precise, error) {
+	value := fmt.Sprintf("%02d:%02d:%02d.%09d", hour, minute, second, nsec)
+	if nsec < 0 || nsec >= int(nanosInSecond) {
+		return 0, errorf("NewPrecise", value, ErrInvalidTimeComponent)
+	}
+	if hour == hoursInDay && nsec != 0 {
+		return 0, errorf("NewPrecise", value, ErrEndOfDayExceeded)
+	}
+
+	d, err := New(hour, minute, second)
+	if err != nil {
+		return 0, errorf("NewPrecise", value, unwrapSentinel(err))
+	}
+	return d.Precise() + Precise(nsec), nil
+}
+
+// MustPrecise creates a new precise daytime, panicking on error.
+func MustPrecise(hour, minute, second, nsec int) Precise {
+	p, err := NewPrecise(hour, minute, second, nsec)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// PreciseFromTime creates a precise daytime from time.Time, keeping nanoseconds.
+func PreciseFromTime(t time.Time) Precise {
+	return fromTime(t).Precise() + Precise(t.Nanosecond())
+}
+
+// ParsePrecise parses a precise daytime from string.
+//
+// Supported input formats:
+//
+//   - "HH:MM:SS": hours:minutes:seconds (e.g., "09:30:00")
+//   - "HH:MM:SS.fffffffff": with 1 to 9 fractional digits (e.g., "09:30:00.125")
+//
+// Returns error if the string cannot be parsed or the value exceeds 24:00:00.
+func ParsePrecise(s string) (Precise, error) {
+	whole, frac, hasFrac := strings.Cut(s, ".")
+	sec, err := parseTimeString(whole)
+	if err != nil {
+		return 0, errorf("ParsePrecise", s, ErrInvalidFormat)
+	}
+	if !hasFrac {
+		return Daytime(sec).Precise(), nil
+	}
+
+	if frac == "" || len(frac) > 9 || strings.Trim(frac, "0123456789") != "" {
+		return 0, errorf("ParsePrecise", s, ErrInvalidFormat)
+	}
+	var nsec int64
+	for i := range 9 {
+		nsec *= 10
+		if i < len(frac) {
+			nsec += int64(frac[i] - '0')
+		}
+	}
+	if sec == secondsInDay && nsec != 0 {
+		return 0, errorf("ParsePrecise", s, ErrEndOfDayExceeded)
+	}
+	return Daytime(sec).Precise() + Precise(nsec), nil
+}
+
+// --- Conversions between Daytime and Precise ---
+
+// Precise converts the daytime to a precise daytime without loss.
+func (d Daytime) Precise() Precise {
+	return Precise(int64(d) * nanosInSecond)
+}
+
+// Daytime converts the precise daytime to whole seconds using the rounding mode.
+//
+// Rounding up from the last second of the day yields EndOfDay.
+// Returns ErrValueOutOfRange for invalid precise daytimes.
+func (p Precise) Daytime(mode Rounding) (Daytime, error) {
+	if !p.Valid() {
+		return 0, errorf("Daytime", int64(p), ErrValueOutOfRange)
+	}
+
+	sec, frac := int64(p)/nanosInSecond, int64(p)%nanosInSecond
+	switch mode {
+	case RoundDown:
+	case RoundNearest:
+		if frac >= nanosInSecond/2 {
+			sec++
+		}
+	case RoundUp:
+		if frac > 0 {
+			sec++
+		}
+	default:
+		return 0, errorf("Daytime", mode, ErrValueOutOfRange)
+	}
+	return Daytime(sec), nil
+}
+
+// --- Time Components ---
+
+// Clock returns the hour, minute, and second components of the precise daytime.
+//
+// For PreciseEndOfDay (24:00:00), returns (24, 0, 0).
+func (p Precise) Clock() (hour, minute, second int) {
+	return Daytime(int64(p) / nanosInSecond).Clock()
+}
+
+// Nanosecond returns the nanosecond offset within the second [0, 999999999].
+func (p Precise) Nanosecond() int {
+	return int(int64(p) % nanosInSecond)
+}
+
+// Duration returns the precise daytime as time.Duration since midnight.
+func (p Precise) Duration() time.Duration {
+	return time.Duration(p)
+}
+
+// --- Comparison Operations ---
+
+// Compare compares two precise daytimes.
+//
+// Returns:
+//
+//	-1 if p < other
+//	 0 if p == other
+//	+1 if p > other
+func (p Precise) Compare(other Precise) int {
+	switch {
+	case p < other:
+		return -1
+	case p > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Before reports whether the precise daytime occurs before the other.
+func (p Precise) Before(other Precise) bool {
+	return p < other
+}
+
+// After reports whether the precise daytime occurs after the other.
+func (p Precise) After(other Precise) bool {
+	return p > other
+}
+
+// Equal reports whether two precise daytimes represent the same time.
+func (p Precise) Equal(other Precise) bool {
+	return p == other
+}
+
+// Between reports whether the precise daytime is between start and end [start; end].
+//
+// Handles midnight wraparound like Daytime.Between.
+func (p Precise) Between(start, end Precise) bool {
+	if !p.Valid() || !start.Valid() || !end.Valid() {
+		return false
+	}
+	if start == end {
+		return p == start
+	}
+	if start.Before(end) {
+		return !p.Before(start) && !p.After(end)
+	}
+	return !p.Before(start) || !p.After(end)
+}
+
+// --- Arithmetic Operations ---
+
+// Add adds a duration to the precise daytime.
+//
+// Returns the resulting precise daytime (normalized to [0, 24:00:00]) and the number
+// of day boundaries crossed, following the same rules as Daytime.Add.
+func (p Precise) Add(dur time.Duration) (Precise, int) {
+	if !p.Valid() {
+		return p, 0
+	}
+
+	total := int64(p) + int64(dur)
+	if total == nanosInDay {
+		return PreciseEndOfDay, 0
+	}
+
+	days := total / nanosInDay
+	remainder := total % nanosInDay
+	if remainder < 0 {
+		remainder += nanosInDay
+		days--
+	}
+	return Precise(remainder), int(days)
+}
+
+// Sub subtracts a duration from the precise daytime.
+//
+// Returns the resulting precise daytime and the number of day boundaries crossed.
+func (p Precise) Sub(dur time.Duration) (Precise, int) {
+	return p.Add(-dur)
+}
+
+// Diff calculates the difference between two precise daytimes (p - other).
+//
+// Returns the difference normalized to [0, 24h) and the number of day boundaries crossed,
+// following the same rules as Daytime.Diff.
+func (p Precise) Diff(other Precise) (time.Duration, int) {
+	if !p.Valid() || !other.Valid() {
+		return 0, 0
+	}
+
+	diff := int64(p) - int64(other)
+	days := diff / nanosInDay
+	remainder := diff % nanosInDay
+	if remainder < 0 {
+		remainder += nanosInDay
+		days--
+	}
+	return time.Duration(remainder), int(days)
+}
+
+// --- Conversions ---
+
+// Time creates a time.Time by combining the precise daytime with a base date.
+//
+// For PreciseEndOfDay (24:00:00), returns the start of the next day.
+// The time zone is taken from the base time.
+func (p Precise) Time(base time.Time) time.Time {
+	return Daytime(int64(p) / nanosInSecond).Time(base).Add(time.Duration(p.Nanosecond()))
+}
+
+// --- String Representations ---
+
+// String returns the string representation in HH:MM:SS.fffffffff format.
+//
+// Trailing zeros of the fraction are omitted, and whole seconds are formatted as HH:MM:SS.
+// Returns "invalid" for invalid precise daytimes.
+func (p Precise) String() string {
+	if !p.Valid() {
+		return "invalid"
+	}
+
+	s := Daytime(int64(p) / nanosInSecond).String()
+	if nsec := p.Nanosecond(); nsec != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", nsec), "0")
+	}
+	return s
+}
+
+// --- Helper functions ---
+
+// unwrapSentinel returns the sentinel error wrapped by a daytime error.
+func unwrapSentinel(err error) error {
+	if inner := errors.Unwrap(err); inner != nil {
+		return inner
+	}
+	return err
+}
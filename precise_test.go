@@ -0,0 +1,247 @@
+package daytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewPrecise(t *testing.T) {
+	tests := []struct {
+		name        string
+		h, m, s, ns int
+		want        Precise
+		err         error
+	}{
+		{"StartOfDay", 0, 0, 0, 0, PreciseStartOfDay, nil},
+		{"EndOfDay", 24, 0, 0, 0, PreciseEndOfDay, nil},
+		{"With nanoseconds", 12, 30, 45, 123456789, Precise(45045*nanosInSecond + 123456789), nil},
+		{"Error: Negative nanoseconds", 12, 0, 0, -1, 0, ErrInvalidTimeComponent},
+		{"Error: Nanoseconds overflow", 12, 0, 0, 1e9, 0, ErrInvalidTimeComponent},
+		{"Error: Minute too large", 12, 60, 0, 0, 0, ErrInvalidTimeComponent},
+		{"Error: 24:00:00 with nanoseconds", 24, 0, 0, 1, 0, ErrEndOfDayExceeded},
+		{"Error: 24:01:00", 24, 1, 0, 0, 0, ErrEndOfDayExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPrecise(tt.h, tt.m, tt.s, tt.ns)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("NewPrecise() got error %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("NewPrecise() got %s, want %s", got, tt.want)
+			}
+			var daytimeErr *Error
+			if tt.err != nil && (!errors.As(err, &daytimeErr) || daytimeErr.Operation() != "NewPrecise") {
+				t.Errorf("NewPrecise() got error %v, want operation NewPrecise", err)
+			}
+		})
+	}
+}
+
+func TestParsePrecise(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Precise
+		err   error
+	}{
+		{"Whole seconds", "12:30:45", MustPrecise(12, 30, 45, 0), nil},
+		{"Milliseconds", "12:30:45.125", MustPrecise(12, 30, 45, 125000000), nil},
+		{"Nanoseconds", "12:30:45.000000001", MustPrecise(12, 30, 45, 1), nil},
+		{"EndOfDay", "24:00:00", PreciseEndOfDay, nil},
+		{"EndOfDay with zero fraction", "24:00:00.000", PreciseEndOfDay, nil},
+		{"Error: EndOfDay exceeded", "24:00:00.5", 0, ErrEndOfDayExceeded},
+		{"Error: Empty fraction", "12:30:45.", 0, ErrInvalidFormat},
+		{"Error: Too many digits", "12:30:45.1234567890", 0, ErrInvalidFormat},
+		{"Error: Non-digit fraction", "12:30:45.12a", 0, ErrInvalidFormat},
+		{"Error: Invalid time", "12:30", 0, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePrecise(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParsePrecise(%q) got error %v, want %v", tt.input, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePrecise(%q) got %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrecise_String(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Precise
+		want string
+	}{
+		{"Whole seconds", MustPrecise(12, 30, 45, 0), "12:30:45"},
+		{"Milliseconds", MustPrecise(12, 30, 45, 125000000), "12:30:45.125"},
+		{"Nanoseconds", MustPrecise(0, 0, 0, 1), "00:00:00.000000001"},
+		{"EndOfDay", PreciseEndOfDay, "24:00:00"},
+		{"Invalid", PreciseEndOfDay + 1, "invalid"},
+		{"Negative", Precise(-1), "invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.String(); got != tt.want {
+				t.Errorf("Precise.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrecise_Conversions(t *testing.T) {
+	t.Run("Daytime to Precise is lossless", func(t *testing.T) {
+		for _, d := range []Daytime{D000000, D123045, D235959, D240000} {
+			p := d.Precise()
+			back, err := p.Daytime(RoundDown)
+			if err != nil || back != d {
+				t.Errorf("%s round trip got (%s, %v)", d, back, err)
+			}
+		}
+	})
+
+	tests := []struct {
+		name string
+		p    Precise
+		mode Rounding
+		want Daytime
+		err  error
+	}{
+		{"Down", MustPrecise(12, 30, 45, 999999999), RoundDown, D123045, nil},
+		{"Nearest below half", MustPrecise(12, 30, 44, 499999999), RoundNearest, Must(12, 30, 44), nil},
+		{"Nearest at half", MustPrecise(12, 30, 44, 500000000), RoundNearest, D123045, nil},
+		{"Up", MustPrecise(12, 30, 44, 1), RoundUp, D123045, nil},
+		{"Up on whole second", MustPrecise(12, 30, 45, 0), RoundUp, D123045, nil},
+		{"Up into EndOfDay", MustPrecise(23, 59, 59, 1), RoundUp, D240000, nil},
+		{"Nearest into EndOfDay", MustPrecise(23, 59, 59, 500000000), RoundNearest, D240000, nil},
+		{"Error: Invalid precise", PreciseEndOfDay + 1, RoundDown, 0, ErrValueOutOfRange},
+		{"Error: Unknown mode", PreciseStartOfDay, Rounding(9), 0, ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p.Daytime(tt.mode)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Daytime(%d) got error %v, want %v", tt.mode, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("%s.Daytime(%d) got %s, want %s", tt.p, tt.mode, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("PreciseFromTime keeps nanoseconds", func(t *testing.T) {
+		tm := time.Date(2025, time.January, 10, 12, 30, 45, 123, time.UTC)
+		if got, want := PreciseFromTime(tm), MustPrecise(12, 30, 45, 123); got != want {
+			t.Errorf("PreciseFromTime() got %s, want %s", got, want)
+		}
+	})
+}
+
+func TestPrecise_Clock(t *testing.T) {
+	p := MustPrecise(17, 30, 45, 250)
+	if h, m, s := p.Clock(); h != 17 || m != 30 || s != 45 {
+		t.Errorf("Clock() got %02d:%02d:%02d, want 17:30:45", h, m, s)
+	}
+	if ns := p.Nanosecond(); ns != 250 {
+		t.Errorf("Nanosecond() got %d, want 250", ns)
+	}
+	if h, m, s := PreciseEndOfDay.Clock(); h != 24 || m != 0 || s != 0 {
+		t.Errorf("PreciseEndOfDay.Clock() got %02d:%02d:%02d, want 24:00:00", h, m, s)
+	}
+}
+
+func TestPrecise_AddAndDiff(t *testing.T) {
+	half := 500 * time.Millisecond
+
+	tests := []struct {
+		name     string
+		p        Precise
+		dur      time.Duration
+		want     Precise
+		wantDays int
+	}{
+		{"Simple forward", MustPrecise(12, 0, 0, 0), half, MustPrecise(12, 0, 0, 500000000), 0},
+		{"Exactly EndOfDay", MustPrecise(23, 59, 59, 500000000), half, PreciseEndOfDay, 0},
+		{"Cross midnight", MustPrecise(23, 59, 59, 500000000), time.Second, MustPrecise(0, 0, 0, 500000000), 1},
+		{"Backward across midnight", MustPrecise(0, 0, 0, 0), -half, MustPrecise(23, 59, 59, 500000000), -1},
+		{"EndOfDay forward", PreciseEndOfDay, time.Nanosecond, MustPrecise(0, 0, 0, 1), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, days := tt.p.Add(tt.dur)
+			if got != tt.want || days != tt.wantDays {
+				t.Errorf("%s.Add(%v) got (%s, %d), want (%s, %d)", tt.p, tt.dur, got, days, tt.want, tt.wantDays)
+			}
+			got, days = tt.p.Sub(-tt.dur)
+			if got != tt.want || days != tt.wantDays {
+				t.Errorf("%s.Sub(%v) got (%s, %d), want (%s, %d)", tt.p, -tt.dur, got, days, tt.want, tt.wantDays)
+			}
+		})
+	}
+
+	t.Run("Diff matches Daytime.Diff on whole seconds", func(t *testing.T) {
+		pairs := [][2]Daytime{{D120000, D010000}, {D010000, D120000}, {D240000, D000000}, {D230000, D240000}}
+		for _, pair := range pairs {
+			wantSec, wantDays := pair[0].Diff(pair[1])
+			gotDur, gotDays := pair[0].Precise().Diff(pair[1].Precise())
+			if gotDur != time.Duration(wantSec)*time.Second || gotDays != wantDays {
+				t.Errorf("Diff(%s - %s) got (%v, %d), want (%ds, %d)", pair[0], pair[1], gotDur, gotDays, wantSec, wantDays)
+			}
+		}
+	})
+
+	t.Run("Diff with nanoseconds", func(t *testing.T) {
+		got, days := MustPrecise(0, 0, 0, 250).Diff(MustPrecise(23, 59, 59, 999999750))
+		if got != 500*time.Nanosecond || days != -1 {
+			t.Errorf("Diff got (%v, %d), want (500ns, -1)", got, days)
+		}
+	})
+}
+
+func TestPrecise_Between(t *testing.T) {
+	start, end := MustPrecise(23, 0, 0, 0), MustPrecise(1, 0, 0, 0)
+
+	tests := []struct {
+		name string
+		p    Precise
+		want bool
+	}{
+		{"Inside before midnight", MustPrecise(23, 59, 59, 999999999), true},
+		{"EndOfDay", PreciseEndOfDay, true},
+		{"StartOfDay", PreciseStartOfDay, true},
+		{"Just after end", MustPrecise(1, 0, 0, 1), false},
+		{"Outside", MustPrecise(12, 0, 0, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Between(start, end); got != tt.want {
+				t.Errorf("%s.Between(%s, %s) got %t, want %t", tt.p, start, end, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrecise_Time(t *testing.T) {
+	base := time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC)
+
+	got := MustPrecise(12, 30, 45, 123).Time(base)
+	want := time.Date(2025, time.January, 10, 12, 30, 45, 123, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Time() got %s, want %s", got, want)
+	}
+
+	got = PreciseEndOfDay.Time(base)
+	want = time.Date(2025, time.January, 11, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("PreciseEndOfDay.Time() got %s, want %s", got, want)
+	}
+}
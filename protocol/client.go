@@ -0,0 +1,117 @@
+package protocol
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+// maxDaytimeResponse bounds the length of a Daytime Protocol response read by the client.
+const maxDaytimeResponse = 512
+
+// Client queries Daytime and Time Protocol servers.
+//
+// The zero value is ready to use and queries over TCP with a timeout of five seconds.
+type Client struct {
+	// Network is "tcp" or "udp", or a variant such as "tcp4". Empty means "tcp".
+	Network string
+
+	// Timeout bounds each query in addition to the context. Zero means five seconds.
+	Timeout time.Duration
+
+	// Location interprets Daytime responses that have no zone. Nil means UTC.
+	Location *time.Location
+}
+
+// QueryDaytime returns the response line of the Daytime server at addr, without the line break.
+func (c *Client) QueryDaytime(ctx context.Context, addr string) (string, error) {
+	data, err := c.query(ctx, addr, func(conn net.Conn) ([]byte, error) {
+		return io.ReadAll(io.LimitReader(conn, maxDaytimeResponse))
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// DaytimeTime queries the Daytime server at addr and parses the response with ParseDaytime.
+func (c *Client) DaytimeTime(ctx context.Context, addr string) (time.Time, error) {
+	text, err := c.QueryDaytime(ctx, addr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return ParseDaytime(text, c.Location)
+}
+
+// Daytime queries the Daytime server at addr and returns the time of day it reports,
+// in the time zone of the response.
+func (c *Client) Daytime(ctx context.Context, addr string) (daytime.Daytime, error) {
+	t, err := c.DaytimeTime(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	return daytime.FromTime(t), nil
+}
+
+// Time queries the Time server at addr and returns the time it reports, in UTC.
+func (c *Client) Time(ctx context.Context, addr string) (time.Time, error) {
+	data, err := c.query(ctx, addr, func(conn net.Conn) ([]byte, error) {
+		buf := make([]byte, 4)
+		_, err := io.ReadFull(conn, buf)
+		return buf, err
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(data) != 4 {
+		return time.Time{}, fmt.Errorf("protocol: time response of %d bytes: %w", len(data), daytime.ErrInvalidFormat)
+	}
+	return DecodeTime(binary.BigEndian.Uint32(data)), nil
+}
+
+// query connects to addr, sends an empty datagram for packet networks,
+// and reads the response with read.
+func (c *Client) query(ctx context.Context, addr string, read func(net.Conn) ([]byte, error)) ([]byte, error) {
+	network := c.Network
+	if network == "" {
+		network = "tcp"
+	}
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if _, isPacket := conn.(net.PacketConn); isPacket {
+		if _, err := conn.Write(nil); err != nil {
+			return nil, err
+		}
+		// A datagram carries the whole response.
+		buf := make([]byte, maxDaytimeResponse)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	}
+	return read(conn)
+}
@@ -0,0 +1,124 @@
+package protocol
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+// dateLayout is the date part of DefaultFormat, modelled on the example of RFC 867.
+const dateLayout = "Monday, January 2, 2006"
+
+// DefaultFormat formats a Daytime Protocol response such as "Sunday, June 1, 2025 12:30:45 +0200".
+//
+// The time of day is formatted with Daytime.String and the zone as a numeric offset,
+// so that ParseDaytime restores the instant exactly.
+func DefaultFormat(t time.Time) string {
+	return t.Format(dateLayout) + " " + daytime.FromTime(t).String() + " " + t.Format("-0700")
+}
+
+// DaytimeServer serves the Daytime Protocol (RFC 867).
+//
+// The zero value is ready to use and answers with the system time in the local time zone.
+type DaytimeServer struct {
+	// Location is the time zone of the responses. Nil means time.Local.
+	Location *time.Location
+
+	// Format formats the response line, without the line break. Nil means DefaultFormat.
+	Format func(t time.Time) string
+
+	// Clock provides the current time. Nil means daytime.SystemClock.
+	Clock daytime.Clock
+
+	server
+}
+
+// Serve answers the connections accepted on l until the listener fails or the server is closed.
+//
+// Serve always closes l, and returns ErrServerClosed after Close.
+func (s *DaytimeServer) Serve(l net.Listener) error {
+	return s.serve(l, s.response)
+}
+
+// ServePacket answers the datagrams received on conn until it fails or the server is closed.
+//
+// ServePacket always closes conn, and returns ErrServerClosed after Close.
+func (s *DaytimeServer) ServePacket(conn net.PacketConn) error {
+	return s.servePacket(conn, s.response)
+}
+
+// ListenAndServe listens on the TCP address and serves it. See Serve.
+func (s *DaytimeServer) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// ListenAndServePacket listens on the UDP address and serves it. See ServePacket.
+func (s *DaytimeServer) ListenAndServePacket(addr string) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	return s.ServePacket(conn)
+}
+
+// Close stops the server, closing all listeners and packet connections it serves.
+func (s *DaytimeServer) Close() error {
+	return s.close()
+}
+
+// response builds the response line.
+func (s *DaytimeServer) response() []byte {
+	loc := s.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	format := s.Format
+	if format == nil {
+		format = DefaultFormat
+	}
+	return []byte(format(now(s.Clock).In(loc)) + "\r\n")
+}
+
+// daytimeLayouts are the response formats recognized by ParseDaytime, in order.
+var daytimeLayouts = []string{
+	dateLayout + " 15:04:05 -0700",
+	dateLayout + " 15:04:05-MST",
+	dateLayout + " 15:04:05 MST",
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RubyDate,
+	time.UnixDate,
+	time.ANSIC,
+	"02 Jan 06 15:04:05 MST",
+	time.DateTime,
+}
+
+// ParseDaytime parses a Daytime Protocol response.
+//
+// It recognizes DefaultFormat, the examples of RFC 867, and common formats such as
+// RFC 3339, RFC 1123 and the output of ctime(3). Responses without a zone are
+// interpreted in loc; a nil loc means UTC. A zone abbreviation other than UTC or
+// the abbreviations of loc yields a time with an unknown offset, as in time.Parse.
+//
+// Returns an error wrapping daytime.ErrInvalidFormat if no format matches.
+func ParseDaytime(text string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	text = strings.TrimSpace(text)
+	for _, layout := range daytimeLayouts {
+		if t, err := time.ParseInLocation(layout, text, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("protocol: parse daytime response %q: %w", text, daytime.ErrInvalidFormat)
+}
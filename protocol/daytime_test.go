@@ -0,0 +1,145 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/pacrock/daytime"
+)
+
+func TestDefaultFormat(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() failed: %v", err)
+	}
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{time.Date(2025, time.June, 1, 12, 30, 45, 0, berlin), "Sunday, June 1, 2025 12:30:45 +0200"},
+		{time.Date(1982, time.February, 22, 17, 37, 43, 0, time.UTC), "Monday, February 22, 1982 17:37:43 +0000"},
+	}
+
+	for _, tt := range tests {
+		if got := DefaultFormat(tt.t); got != tt.want {
+			t.Errorf("DefaultFormat() got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestParseDaytime(t *testing.T) {
+	est := time.FixedZone("EST", -5*3600)
+
+	tests := []struct {
+		name string
+		text string
+		want time.Time
+		err  error
+	}{
+		{"Default format", "Sunday, June 1, 2025 12:30:45 +0200\r\n", time.Date(2025, time.June, 1, 10, 30, 45, 0, time.UTC), nil},
+		{"RFC 867 example", "Tuesday, February 22, 1982 17:37:43-UTC", time.Date(1982, time.February, 22, 17, 37, 43, 0, time.UTC), nil},
+		{"RFC 867 short example", "22 FEB 82 17:37:43 UTC", time.Date(1982, time.February, 22, 17, 37, 43, 0, time.UTC), nil},
+		{"RFC 3339", "2025-06-01T12:30:45-05:00", time.Date(2025, time.June, 1, 12, 30, 45, 0, est), nil},
+		{"RFC 1123", "Sun, 01 Jun 2025 12:30:45 -0500", time.Date(2025, time.June, 1, 12, 30, 45, 0, est), nil},
+		{"ctime without zone", "Sun Jun  1 12:30:45 2025", time.Date(2025, time.June, 1, 12, 30, 45, 0, time.UTC), nil},
+		{"Error: Garbage", "the time is now", time.Time{}, daytime.ErrInvalidFormat},
+		{"Error: Empty", "", time.Time{}, daytime.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDaytime(tt.text, nil)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseDaytime() got error %v, want %v", err, tt.err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDaytime() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaytimeServer(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() failed: %v", err)
+	}
+	instant := time.Date(2025, time.June, 1, 10, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		server *DaytimeServer
+		text   string
+		want   daytime.Daytime
+	}{
+		{
+			"Default format", &DaytimeServer{Location: berlin, Clock: daytime.NewFakeClock(instant)},
+			"Sunday, June 1, 2025 12:30:45 +0200", daytime.Must(12, 30, 45),
+		},
+		{
+			"Custom format", &DaytimeServer{
+				Location: time.UTC,
+				Clock:    daytime.NewFakeClock(instant),
+				Format: func(t time.Time) string {
+					return t.Format(time.DateOnly) + "T" + daytime.FromTime(t).String() + "Z"
+				},
+			},
+			"2025-06-01T10:30:45Z", daytime.Must(10, 30, 45),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tcpAddr, udpAddr := startServer(t, tt.server)
+			ctx := context.Background()
+
+			for _, network := range []string{"tcp", "udp"} {
+				addr := tcpAddr
+				if network == "udp" {
+					addr = udpAddr
+				}
+				c := &Client{Network: network, Timeout: 5 * time.Second}
+
+				text, err := c.QueryDaytime(ctx, addr)
+				if err != nil || text != tt.text {
+					t.Errorf("%s: QueryDaytime() got (%q, %v), want %q", network, text, err, tt.text)
+				}
+				got, err := c.DaytimeTime(ctx, addr)
+				if err != nil || !got.Equal(instant) {
+					t.Errorf("%s: DaytimeTime() got (%v, %v), want %v", network, got, err, instant)
+				}
+				d, err := c.Daytime(ctx, addr)
+				if err != nil || d != tt.want {
+					t.Errorf("%s: Daytime() got (%s, %v), want %s", network, d, err, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDaytimeServer_SystemClock(t *testing.T) {
+	tcpAddr, _ := startServer(t, &DaytimeServer{})
+
+	before := time.Now().Truncate(time.Second)
+	got, err := (&Client{}).DaytimeTime(context.Background(), tcpAddr)
+	if err != nil {
+		t.Fatalf("DaytimeTime() failed: %v", err)
+	}
+	if got.Before(before) || got.After(time.Now()) {
+		t.Errorf("DaytimeTime() got %v, want about %v", got, before)
+	}
+}
+
+func TestClient_Daytime_Unparsable(t *testing.T) {
+	s := &DaytimeServer{Format: func(time.Time) string { return strings.Repeat("?", 10) }}
+	tcpAddr, _ := startServer(t, s)
+
+	if _, err := (&Client{}).Daytime(context.Background(), tcpAddr); !errors.Is(err, daytime.ErrInvalidFormat) {
+		t.Errorf("Daytime() got error %v, want %v", err, daytime.ErrInvalidFormat)
+	}
+}
@@ -0,0 +1,155 @@
+// Package protocol implements the Daytime Protocol (RFC 867) and the Time Protocol (RFC 868)
+// over TCP and UDP.
+//
+// A Daytime server answers with the current date and time as a line of text, a Time server
+// with the seconds since 1900-01-01 00:00:00 UTC as a 32-bit big-endian number. Both close
+// a TCP connection after answering and reply to every UDP datagram.
+package protocol
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+// Well-known ports of the protocols.
+const (
+	DaytimePort = 13
+	TimePort    = 37
+)
+
+// ErrServerClosed is returned by the Serve methods after the server has been closed.
+var ErrServerClosed = errors.New("protocol: server closed")
+
+// writeTimeout bounds the time spent answering a single client.
+const writeTimeout = 5 * time.Second
+
+// server serves a response built for each request over stream and packet connections.
+type server struct {
+	mu        sync.Mutex
+	listeners map[net.Listener]struct{}
+	packets   map[net.PacketConn]struct{}
+	closed    bool
+}
+
+// serve accepts connections on l and writes the response to each. It closes l when done.
+func (s *server) serve(l net.Listener, response func() []byte) error {
+	defer l.Close()
+	if !s.track(l, nil) {
+		return ErrServerClosed
+	}
+	defer s.untrack(l, nil)
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return ErrServerClosed
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				continue
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			_, _ = conn.Write(response())
+		}()
+	}
+}
+
+// servePacket answers every datagram received on conn with the response. It closes conn when done.
+func (s *server) servePacket(conn net.PacketConn, response func() []byte) error {
+	defer conn.Close()
+	if !s.track(nil, conn) {
+		return ErrServerClosed
+	}
+	defer s.untrack(nil, conn)
+
+	buf := make([]byte, 512)
+	for {
+		_, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if s.isClosed() {
+				return ErrServerClosed
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				continue
+			}
+			return err
+		}
+		_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		_, _ = conn.WriteTo(response(), addr)
+	}
+}
+
+// close closes all listeners and packet connections being served.
+func (s *server) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	var errs []error
+	for l := range s.listeners {
+		errs = append(errs, l.Close())
+	}
+	for conn := range s.packets {
+		errs = append(errs, conn.Close())
+	}
+	clear(s.listeners)
+	clear(s.packets)
+	return errors.Join(errs...)
+}
+
+// track registers a listener or packet connection, reporting false if the server is closed.
+func (s *server) track(l net.Listener, conn net.PacketConn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+	if l != nil {
+		if s.listeners == nil {
+			s.listeners = make(map[net.Listener]struct{})
+		}
+		s.listeners[l] = struct{}{}
+	}
+	if conn != nil {
+		if s.packets == nil {
+			s.packets = make(map[net.PacketConn]struct{})
+		}
+		s.packets[conn] = struct{}{}
+	}
+	return true
+}
+
+// untrack removes a listener or packet connection registered by track.
+func (s *server) untrack(l net.Listener, conn net.PacketConn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.listeners, l)
+	delete(s.packets, conn)
+}
+
+// isClosed reports whether close has been called.
+func (s *server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// now returns the current time of the clock, or of the system clock if it is nil.
+func now(clock daytime.Clock) time.Time {
+	if clock == nil {
+		clock = daytime.SystemClock
+	}
+	return clock.Now()
+}
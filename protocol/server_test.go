@@ -0,0 +1,97 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// testServer is implemented by DaytimeServer and TimeServer.
+type testServer interface {
+	Serve(l net.Listener) error
+	ServePacket(conn net.PacketConn) error
+	Close() error
+}
+
+// startServer serves on loopback TCP and UDP ports and returns their addresses.
+// The server is closed when the test ends.
+func startServer(t *testing.T, s testServer) (tcpAddr, udpAddr string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() failed: %v", err)
+	}
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket() failed: %v", err)
+	}
+
+	done := make(chan error, 2)
+	go func() { done <- s.Serve(l) }()
+	go func() { done <- s.ServePacket(conn) }()
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Errorf("Close() failed: %v", err)
+		}
+		for range 2 {
+			if err := <-done; !errors.Is(err, ErrServerClosed) {
+				t.Errorf("Serve() got error %v, want %v", err, ErrServerClosed)
+			}
+		}
+	})
+	return l.Addr().String(), conn.LocalAddr().String()
+}
+
+func TestServer_ServeAfterClose(t *testing.T) {
+	var s DaytimeServer
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() failed: %v", err)
+	}
+	if err := s.Serve(l); !errors.Is(err, ErrServerClosed) {
+		t.Errorf("Serve() got error %v, want %v", err, ErrServerClosed)
+	}
+	// Serve closes the listener.
+	if _, err := l.Accept(); err == nil {
+		t.Error("Accept() on listener after Serve succeeded, want error")
+	}
+}
+
+func TestClient_Timeout(t *testing.T) {
+	// A listener that never answers.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() failed: %v", err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	c := Client{Timeout: 50 * time.Millisecond}
+	start := time.Now()
+	if _, err := c.Time(context.Background(), l.Addr().String()); err == nil {
+		t.Fatal("Time() succeeded, want timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Time() took %v, want about the timeout", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := (&Client{}).QueryDaytime(ctx, l.Addr().String()); err == nil {
+		t.Error("QueryDaytime() with canceled context succeeded, want error")
+	}
+}
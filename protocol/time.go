@@ -0,0 +1,89 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+// Time Protocol values count seconds since 1900-01-01 00:00:00 UTC modulo 2^32.
+const (
+	// epochOffset is the number of seconds from 1900-01-01 to 1970-01-01.
+	epochOffset = 2208988800
+
+	// eraSeconds is the length of one era of 32-bit values (about 136 years).
+	eraSeconds = 1 << 32
+)
+
+// EncodeTime returns the Time Protocol value of t.
+//
+// Times after 2036-02-07 06:28:15 UTC wrap around to zero, as the protocol specifies.
+func EncodeTime(t time.Time) uint32 {
+	return uint32(t.Unix() + epochOffset)
+}
+
+// DecodeTime returns the time of a Time Protocol value.
+//
+// Values with the high bit clear are taken to be in the era starting 2036-02-07,
+// so the decoded times range from 1968-01-20 to 2104-02-26.
+func DecodeTime(value uint32) time.Time {
+	seconds := int64(value) - epochOffset
+	if value&(1<<31) == 0 {
+		seconds += eraSeconds
+	}
+	return time.Unix(seconds, 0).UTC()
+}
+
+// TimeServer serves the Time Protocol (RFC 868).
+//
+// The zero value is ready to use and answers with the system time.
+type TimeServer struct {
+	// Clock provides the current time. Nil means daytime.SystemClock.
+	Clock daytime.Clock
+
+	server
+}
+
+// Serve answers the connections accepted on l until the listener fails or the server is closed.
+//
+// Serve always closes l, and returns ErrServerClosed after Close.
+func (s *TimeServer) Serve(l net.Listener) error {
+	return s.serve(l, s.response)
+}
+
+// ServePacket answers the datagrams received on conn until it fails or the server is closed.
+//
+// ServePacket always closes conn, and returns ErrServerClosed after Close.
+func (s *TimeServer) ServePacket(conn net.PacketConn) error {
+	return s.servePacket(conn, s.response)
+}
+
+// ListenAndServe listens on the TCP address and serves it. See Serve.
+func (s *TimeServer) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// ListenAndServePacket listens on the UDP address and serves it. See ServePacket.
+func (s *TimeServer) ListenAndServePacket(addr string) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	return s.ServePacket(conn)
+}
+
+// Close stops the server, closing all listeners and packet connections it serves.
+func (s *TimeServer) Close() error {
+	return s.close()
+}
+
+// response builds the 4-byte response.
+func (s *TimeServer) response() []byte {
+	return binary.BigEndian.AppendUint32(nil, EncodeTime(now(s.Clock)))
+}
@@ -0,0 +1,51 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pacrock/daytime"
+)
+
+func TestEncodeDecodeTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		t     time.Time
+		value uint32
+	}{
+		{"Unix epoch", time.Unix(0, 0).UTC(), 2208988800},
+		{"RFC 868 example", time.Date(1976, time.January, 1, 0, 0, 0, 0, time.UTC), 2398291200},
+		{"Recent", time.Date(2025, time.June, 1, 10, 30, 45, 0, time.UTC), 3957762645},
+		{"Last second of era 0", time.Date(2036, time.February, 7, 6, 28, 15, 0, time.UTC), 1<<32 - 1},
+		{"Start of era 1", time.Date(2036, time.February, 7, 6, 28, 16, 0, time.UTC), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EncodeTime(tt.t); got != tt.value {
+				t.Errorf("EncodeTime() got %d, want %d", got, tt.value)
+			}
+			if got := DecodeTime(tt.value); !got.Equal(tt.t) {
+				t.Errorf("DecodeTime() got %v, want %v", got, tt.t)
+			}
+		})
+	}
+
+	if got := EncodeTime(time.Date(2025, time.June, 1, 10, 30, 45, 999, time.UTC)); got != 3957762645 {
+		t.Errorf("EncodeTime() with fraction got %d, want whole seconds", got)
+	}
+}
+
+func TestTimeServer(t *testing.T) {
+	instant := time.Date(2025, time.June, 1, 10, 30, 45, 0, time.UTC)
+	tcpAddr, udpAddr := startServer(t, &TimeServer{Clock: daytime.NewFakeClock(instant)})
+
+	for network, addr := range map[string]string{"tcp": tcpAddr, "udp": udpAddr} {
+		c := &Client{Network: network}
+		got, err := c.Time(context.Background(), addr)
+		if err != nil || !got.Equal(instant) {
+			t.Errorf("%s: Time() got (%v, %v), want %v", network, got, err, instant)
+		}
+	}
+}
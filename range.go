@@ -0,0 +1,363 @@
+package daytime
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Bounds describes which endpoints of a Range belong to it.
+type Bounds uint8
+
+const (
+	// ClosedOpen includes the start and excludes the end: [start, end).
+	// This is the zero value and the default for ranges without brackets.
+	ClosedOpen Bounds = iota
+
+	// Closed includes both endpoints: [start, end].
+	Closed
+
+	// OpenClosed excludes the start and includes the end: (start, end].
+	OpenClosed
+
+	// Open excludes both endpoints: (start, end).
+	Open
+)
+
+// includesStart reports whether the bounds include the start endpoint.
+func (b Bounds) includesStart() bool {
+	return b == ClosedOpen || b == Closed
+}
+
+// includesEnd reports whether the bounds include the end endpoint.
+func (b Bounds) includesEnd() bool {
+	return b == Closed || b == OpenClosed
+}
+
+// boundsOf builds bounds from endpoint inclusion flags.
+func boundsOf(startIn, endIn bool) Bounds {
+	switch {
+	case startIn && endIn:
+		return Closed
+	case startIn:
+		return ClosedOpen
+	case endIn:
+		return OpenClosed
+	default:
+		return Open
+	}
+}
+
+// Range represents an interval of daytimes between start and end.
+//
+// If start is after end, the range spans across midnight and covers
+// [start, EndOfDay] together with [StartOfDay, end]; both 24:00:00 and
+// 00:00:00 are interior points of such a range.
+// If start equals end, the range is a single point when Closed and empty otherwise.
+// The full day is represented as [00:00:00-24:00:00].
+//
+// The zero value is the empty range [00:00:00-00:00:00).
+type Range struct {
+	start  Daytime
+	end    Daytime
+	bounds Bounds
+}
+
+// NewRange creates a new range from start to end with the given bounds.
+//
+// Returns ErrValueOutOfRange if start or end is not a valid daytime
+// or the bounds value is unknown.
+func NewRange(start, end Daytime, bounds Bounds) (Range, error) {
+	if !start.Valid() || !end.Valid() || bounds > Open {
+		return Range{}, errorf("NewRange", fmt.Sprintf("%d-%d", start, end), ErrValueOutOfRange)
+	}
+	return Range{start: start, end: end, bounds: bounds}, nil
+}
+
+// MustRange creates a new range, panicking on error.
+func MustRange(start, end Daytime, bounds Bounds) Range {
+	r, err := NewRange(start, end, bounds)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ParseRange parses a range from string.
+//
+// Supported input formats:
+//
+//   - "HH:MM:SS-HH:MM:SS": half-open range [start, end) (e.g., "22:00:00-02:00:00")
+//   - "[HH:MM:SS-HH:MM:SS]": range with explicit bounds, where "[" and "]"
+//     include the endpoint and "(" and ")" exclude it
+//
+// Each endpoint accepts any form supported by Parse.
+func ParseRange(s string) (Range, error) {
+	body := s
+	bounds := ClosedOpen
+	if len(body) >= 2 && strings.ContainsRune("[(", rune(body[0])) {
+		last := body[len(body)-1]
+		if !strings.ContainsRune("])", rune(last)) {
+			return Range{}, errorf("ParseRange", s, ErrInvalidFormat)
+		}
+		bounds = boundsOf(body[0] == '[', last == ']')
+		body = body[1 : len(body)-1]
+	}
+
+	startStr, endStr, ok := strings.Cut(body, "-")
+	if !ok {
+		return Range{}, errorf("ParseRange", s, ErrInvalidFormat)
+	}
+	start, err := Parse(startStr)
+	if err != nil {
+		return Range{}, errorf("ParseRange", s, ErrInvalidFormat)
+	}
+	end, err := Parse(endStr)
+	if err != nil {
+		return Range{}, errorf("ParseRange", s, ErrInvalidFormat)
+	}
+	return Range{start: start, end: end, bounds: bounds}, nil
+}
+
+// Start returns the start of the range.
+func (r Range) Start() Daytime {
+	return r.start
+}
+
+// End returns the end of the range.
+func (r Range) End() Daytime {
+	return r.end
+}
+
+// Bounds returns the endpoint semantics of the range.
+func (r Range) Bounds() Bounds {
+	return r.bounds
+}
+
+// IncludesStart reports whether the start endpoint belongs to the range.
+func (r Range) IncludesStart() bool {
+	return r.bounds.includesStart()
+}
+
+// IncludesEnd reports whether the end endpoint belongs to the range.
+func (r Range) IncludesEnd() bool {
+	return r.bounds.includesEnd()
+}
+
+// Wraps reports whether the range spans across midnight (start is after end).
+func (r Range) Wraps() bool {
+	return r.start.After(r.end)
+}
+
+// IsEmpty reports whether the range contains no daytimes.
+func (r Range) IsEmpty() bool {
+	return r.start == r.end && r.bounds != Closed
+}
+
+// Contains reports whether the daytime belongs to the range.
+func (r Range) Contains(d Daytime) bool {
+	if !d.Valid() {
+		return false
+	}
+	for _, piece := range r.Split() {
+		if piece.containsLinear(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// Duration returns the length of the range.
+//
+// Endpoint inclusion does not affect the length.
+// A range spanning midnight is measured through 24:00:00.
+func (r Range) Duration() time.Duration {
+	if r.Wraps() {
+		return EndOfDay.Duration() - r.start.Duration() + r.end.Duration()
+	}
+	return r.end.Duration() - r.start.Duration()
+}
+
+// Overlaps reports whether the two ranges share at least one daytime.
+func (r Range) Overlaps(other Range) bool {
+	return len(intersectRanges(r.Split(), other.Split())) > 0
+}
+
+// Intersect returns the daytimes belonging to both ranges.
+//
+// The result may consist of up to two ranges when ranges spanning midnight are involved,
+// and is nil if the ranges do not overlap.
+func (r Range) Intersect(other Range) []Range {
+	return joinMidnight(intersectRanges(r.Split(), other.Split()))
+}
+
+// Union returns the daytimes belonging to either range.
+//
+// Overlapping or adjacent ranges are merged into one; otherwise both are returned
+// ordered by start. Pieces meeting at midnight are joined into a range spanning midnight.
+func (r Range) Union(other Range) []Range {
+	pieces := append(r.Split(), other.Split()...)
+	return joinMidnight(normalizeRanges(pieces))
+}
+
+// Split splits a range spanning midnight into [start, 24:00:00] and [00:00:00, end].
+//
+// A range that does not span midnight is returned as is, and an empty range yields nil.
+func (r Range) Split() []Range {
+	if r.IsEmpty() {
+		return nil
+	}
+	if !r.Wraps() {
+		return []Range{r}
+	}
+
+	pieces := []Range{{start: r.start, end: EndOfDay, bounds: boundsOf(r.IncludesStart(), true)}}
+	morning := Range{start: StartOfDay, end: r.end, bounds: boundsOf(true, r.IncludesEnd())}
+	if !morning.IsEmpty() {
+		pieces = append(pieces, morning)
+	}
+	return pieces
+}
+
+// String returns the string representation in HH:MM:SS-HH:MM:SS format.
+//
+// Ranges with bounds other than ClosedOpen are enclosed in brackets,
+// e.g. "[09:00:00-17:00:00]".
+func (r Range) String() string {
+	s := r.start.String() + "-" + r.end.String()
+	if r.bounds == ClosedOpen {
+		return s
+	}
+
+	open, closing := "(", ")"
+	if r.IncludesStart() {
+		open = "["
+	}
+	if r.IncludesEnd() {
+		closing = "]"
+	}
+	return open + s + closing
+}
+
+// --- Helper functions ---
+
+// containsLinear reports whether d belongs to a range that does not span midnight.
+func (r Range) containsLinear(d Daytime) bool {
+	switch {
+	case d == r.start:
+		return r.IncludesStart() || (d == r.end && r.IncludesEnd())
+	case d == r.end:
+		return r.IncludesEnd()
+	default:
+		return d.After(r.start) && d.Before(r.end)
+	}
+}
+
+// compareStarts orders ranges that do not span midnight by their start,
+// placing an included start before an excluded one.
+func compareStarts(a, b Range) int {
+	if c := a.start.Compare(b.start); c != 0 {
+		return c
+	}
+	switch {
+	case a.IncludesStart() == b.IncludesStart():
+		return 0
+	case a.IncludesStart():
+		return -1
+	default:
+		return 1
+	}
+}
+
+// endsAfter reports whether range a extends further than range b.
+func endsAfter(a, b Range) bool {
+	if a.end != b.end {
+		return a.end.After(b.end)
+	}
+	return a.IncludesEnd() && !b.IncludesEnd()
+}
+
+// touches reports whether range b, starting no earlier than a, overlaps or is adjacent to a.
+func touches(a, b Range) bool {
+	if b.start != a.end {
+		return b.start.Before(a.end)
+	}
+	return a.IncludesEnd() || b.IncludesStart()
+}
+
+// normalizeRanges sorts and merges ranges that do not span midnight
+// into a minimal list of disjoint ranges.
+func normalizeRanges(ranges []Range) []Range {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, compareStarts)
+
+	var merged []Range
+	for _, r := range sorted {
+		if r.IsEmpty() {
+			continue
+		}
+		if n := len(merged); n > 0 && touches(merged[n-1], r) {
+			if endsAfter(r, merged[n-1]) {
+				last := merged[n-1]
+				merged[n-1] = Range{start: last.start, end: r.end, bounds: boundsOf(last.IncludesStart(), r.IncludesEnd())}
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// intersectRanges intersects two sorted lists of disjoint ranges that do not span midnight.
+func intersectRanges(a, b []Range) []Range {
+	a, b = normalizeRanges(a), normalizeRanges(b)
+
+	var result []Range
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		var lo, hi Range
+		if compareStarts(b[j], a[i]) > 0 {
+			lo = b[j]
+		} else {
+			lo = a[i]
+		}
+		if endsAfter(a[i], b[j]) {
+			hi = b[j]
+		} else {
+			hi = a[i]
+		}
+
+		piece := Range{start: lo.start, end: hi.end, bounds: boundsOf(lo.IncludesStart(), hi.IncludesEnd())}
+		if !piece.start.After(piece.end) && !piece.IsEmpty() {
+			result = append(result, piece)
+		}
+
+		if endsAfter(a[i], b[j]) {
+			j++
+		} else {
+			i++
+		}
+	}
+	return result
+}
+
+// joinMidnight joins the last range ending at 24:00:00 with the first range
+// starting at 00:00:00 into a single range spanning midnight.
+func joinMidnight(ranges []Range) []Range {
+	n := len(ranges)
+	if n < 2 {
+		return ranges
+	}
+
+	first, last := ranges[0], ranges[n-1]
+	if first.start != StartOfDay || !first.IncludesStart() || last.end != EndOfDay || !last.IncludesEnd() {
+		return ranges
+	}
+	// A day missing a single daytime cannot be expressed as one range.
+	if first.end == last.start {
+		return ranges
+	}
+
+	joined := Range{start: last.start, end: first.end, bounds: boundsOf(last.IncludesStart(), first.IncludesEnd())}
+	return append(slices.Clone(ranges[1:n-1]), joined)
+}
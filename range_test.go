@@ -0,0 +1,305 @@
+package daytime
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		start  Daytime
+		end    Daytime
+		bounds Bounds
+		err    error
+	}{
+		{"Normal range", D010000, D120000, ClosedOpen, nil},
+		{"Wraparound range", D230000, D010000, Closed, nil},
+		{"Full day", D000000, D240000, Closed, nil},
+		{"Invalid start", DInvalid, D120000, ClosedOpen, ErrValueOutOfRange},
+		{"Invalid end", D010000, DInvalid, ClosedOpen, ErrValueOutOfRange},
+		{"Unknown bounds", D010000, D120000, Bounds(42), ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRange(tt.start, tt.end, tt.bounds)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("NewRange() got error %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if r.Start() != tt.start || r.End() != tt.end || r.Bounds() != tt.bounds {
+				t.Errorf("NewRange() got %s, want start %s end %s bounds %d", r, tt.start, tt.end, tt.bounds)
+			}
+		})
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Range
+		err   error
+	}{
+		{"Plain form is half-open", "09:00:00-17:00:00", MustRange(Must(9, 0, 0), Must(17, 0, 0), ClosedOpen), nil},
+		{"Closed brackets", "[09:00:00-17:00:00]", MustRange(Must(9, 0, 0), Must(17, 0, 0), Closed), nil},
+		{"Open brackets", "(09:00:00-17:00:00)", MustRange(Must(9, 0, 0), Must(17, 0, 0), Open), nil},
+		{"Open-closed brackets", "(09:00:00-17:00:00]", MustRange(Must(9, 0, 0), Must(17, 0, 0), OpenClosed), nil},
+		{"Wraparound", "22:00:00-02:00:00", MustRange(Must(22, 0, 0), Must(2, 0, 0), ClosedOpen), nil},
+		{"EndOfDay endpoint", "[18:00:00-24:00:00]", MustRange(D180000, D240000, Closed), nil},
+		{"Seconds endpoints", "3600-7200", MustRange(D010000, Must(2, 0, 0), ClosedOpen), nil},
+		{"Error: Missing separator", "09:00:00", Range{}, ErrInvalidFormat},
+		{"Error: Unbalanced bracket", "[09:00:00-17:00:00", Range{}, ErrInvalidFormat},
+		{"Error: Invalid endpoint", "09:00:00-25:00:00", Range{}, ErrInvalidFormat},
+		{"Error: Empty string", "", Range{}, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRange(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseRange(%q) got error %v, want %v", tt.input, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRange(%q) got %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_String(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		want string
+	}{
+		{"Half-open", MustRange(Must(9, 0, 0), Must(17, 0, 0), ClosedOpen), "09:00:00-17:00:00"},
+		{"Closed", MustRange(Must(9, 0, 0), Must(17, 0, 0), Closed), "[09:00:00-17:00:00]"},
+		{"Open", MustRange(Must(9, 0, 0), Must(17, 0, 0), Open), "(09:00:00-17:00:00)"},
+		{"Open-closed", MustRange(Must(9, 0, 0), Must(17, 0, 0), OpenClosed), "(09:00:00-17:00:00]"},
+		{"EndOfDay", MustRange(D230000, D240000, ClosedOpen), "23:00:00-24:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.String()
+			if got != tt.want {
+				t.Errorf("Range.String() = %q, want %q", got, tt.want)
+			}
+			parsed, err := ParseRange(got)
+			if err != nil || parsed != tt.r {
+				t.Errorf("ParseRange(%q) got (%s, %v), want round trip to %s", got, parsed, err, tt.r)
+			}
+		})
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		d    Daytime
+		want bool
+	}{
+		// Normal ranges
+		{"Half-open: At start", MustRange(D010000, D120000, ClosedOpen), D010000, true},
+		{"Half-open: At end", MustRange(D010000, D120000, ClosedOpen), D120000, false},
+		{"Half-open: Inside", MustRange(D010000, D120000, ClosedOpen), D060000, true},
+		{"Open: At start", MustRange(D010000, D120000, Open), D010000, false},
+		{"Closed: At end", MustRange(D010000, D120000, Closed), D120000, true},
+		{"Closed: Outside", MustRange(D010000, D120000, Closed), D180000, false},
+
+		// EndOfDay handling
+		{"Full day: StartOfDay", MustRange(D000000, D240000, Closed), D000000, true},
+		{"Full day: EndOfDay", MustRange(D000000, D240000, Closed), D240000, true},
+		{"Half-open full day: EndOfDay", MustRange(D000000, D240000, ClosedOpen), D240000, false},
+
+		// Wraparound ranges
+		{"Wraparound: Late evening", MustRange(D230000, D010000, ClosedOpen), D235959, true},
+		{"Wraparound: EndOfDay", MustRange(D230000, D010000, ClosedOpen), D240000, true},
+		{"Wraparound: StartOfDay", MustRange(D230000, D010000, ClosedOpen), D000000, true},
+		{"Wraparound: At excluded end", MustRange(D230000, D010000, ClosedOpen), D010000, false},
+		{"Wraparound: Outside", MustRange(D230000, D010000, ClosedOpen), D120000, false},
+		{"Wraparound from EndOfDay: StartOfDay", MustRange(D240000, D010000, Open), D000000, true},
+
+		// Degenerate ranges
+		{"Point: Closed", MustRange(D120000, D120000, Closed), D120000, true},
+		{"Point: Half-open is empty", MustRange(D120000, D120000, ClosedOpen), D120000, false},
+		{"Invalid daytime", MustRange(D000000, D240000, Closed), DInvalid, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Contains(tt.d); got != tt.want {
+				t.Errorf("%s.Contains(%s) got %t, want %t", tt.r, tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_Duration(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		want time.Duration
+	}{
+		{"Normal", MustRange(D010000, D120000, ClosedOpen), 11 * time.Hour},
+		{"Closed does not add length", MustRange(D010000, D120000, Closed), 11 * time.Hour},
+		{"Full day", MustRange(D000000, D240000, Closed), 24 * time.Hour},
+		{"Wraparound", MustRange(D230000, D010000, ClosedOpen), 2 * time.Hour},
+		{"Wraparound to StartOfDay", MustRange(D230000, D000000, ClosedOpen), time.Hour},
+		{"Empty", Range{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Duration(); got != tt.want {
+				t.Errorf("%s.Duration() got %v, want %v", tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_Split(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		want []Range
+	}{
+		{"Normal range is unchanged", MustRange(D010000, D120000, ClosedOpen), []Range{MustRange(D010000, D120000, ClosedOpen)}},
+		{"Empty range", Range{}, nil},
+		{
+			"Wraparound range",
+			MustRange(D230000, D010000, ClosedOpen),
+			[]Range{MustRange(D230000, D240000, Closed), MustRange(D000000, D010000, ClosedOpen)},
+		},
+		{
+			"Wraparound to excluded StartOfDay",
+			MustRange(D230000, D000000, Open),
+			[]Range{MustRange(D230000, D240000, OpenClosed)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Split(); !slices.Equal(got, tt.want) {
+				t.Errorf("%s.Split() got %v, want %v", tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_IntersectAndOverlaps(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Range
+		b    Range
+		want []Range
+	}{
+		{
+			"Partial overlap",
+			MustRange(D010000, D120000, ClosedOpen), MustRange(D060000, D180000, ClosedOpen),
+			[]Range{MustRange(D060000, D120000, ClosedOpen)},
+		},
+		{
+			"Disjoint",
+			MustRange(D010000, D060000, ClosedOpen), MustRange(D120000, D180000, ClosedOpen),
+			nil,
+		},
+		{
+			"Touching half-open ranges do not overlap",
+			MustRange(D010000, D060000, ClosedOpen), MustRange(D060000, D120000, ClosedOpen),
+			nil,
+		},
+		{
+			"Touching closed ranges share a point",
+			MustRange(D010000, D060000, Closed), MustRange(D060000, D120000, Closed),
+			[]Range{MustRange(D060000, D060000, Closed)},
+		},
+		{
+			"Wraparound with normal",
+			MustRange(D230000, D060000, ClosedOpen), MustRange(D010000, D120000, ClosedOpen),
+			[]Range{MustRange(D010000, D060000, ClosedOpen)},
+		},
+		{
+			"Two wraparound ranges stay joined across midnight",
+			MustRange(D180000, D060000, ClosedOpen), MustRange(D230000, D120000, ClosedOpen),
+			[]Range{MustRange(D230000, D060000, ClosedOpen)},
+		},
+		{
+			"Wraparound intersection in two pieces",
+			MustRange(Must(22, 0, 0), Must(2, 0, 0), ClosedOpen), MustRange(D010000, D230000, ClosedOpen),
+			[]Range{MustRange(D010000, Must(2, 0, 0), ClosedOpen), MustRange(Must(22, 0, 0), D230000, ClosedOpen)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.Intersect(tt.b)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("%s.Intersect(%s) got %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if overlaps := tt.a.Overlaps(tt.b); overlaps != (len(tt.want) > 0) {
+				t.Errorf("%s.Overlaps(%s) got %t, want %t", tt.a, tt.b, overlaps, len(tt.want) > 0)
+			}
+		})
+	}
+}
+
+func TestRange_Union(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Range
+		b    Range
+		want []Range
+	}{
+		{
+			"Overlapping",
+			MustRange(D010000, D120000, ClosedOpen), MustRange(D060000, D180000, ClosedOpen),
+			[]Range{MustRange(D010000, D180000, ClosedOpen)},
+		},
+		{
+			"Adjacent half-open ranges merge",
+			MustRange(D010000, D060000, ClosedOpen), MustRange(D060000, D120000, ClosedOpen),
+			[]Range{MustRange(D010000, D120000, ClosedOpen)},
+		},
+		{
+			"Adjacent open ranges leave a gap",
+			MustRange(D010000, D060000, Open), MustRange(D060000, D120000, Open),
+			[]Range{MustRange(D010000, D060000, Open), MustRange(D060000, D120000, Open)},
+		},
+		{
+			"Disjoint ranges ordered by start",
+			MustRange(D120000, D180000, ClosedOpen), MustRange(D010000, D060000, ClosedOpen),
+			[]Range{MustRange(D010000, D060000, ClosedOpen), MustRange(D120000, D180000, ClosedOpen)},
+		},
+		{
+			"Evening and morning join across midnight",
+			MustRange(D230000, D240000, Closed), MustRange(D000000, D010000, ClosedOpen),
+			[]Range{MustRange(D230000, D010000, ClosedOpen)},
+		},
+		{
+			"Wraparound extended",
+			MustRange(Must(22, 0, 0), Must(2, 0, 0), ClosedOpen), MustRange(D010000, D060000, ClosedOpen),
+			[]Range{MustRange(Must(22, 0, 0), D060000, ClosedOpen)},
+		},
+		{
+			"Whole day",
+			MustRange(D000000, D120000, ClosedOpen), MustRange(D120000, D240000, Closed),
+			[]Range{MustRange(D000000, D240000, Closed)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Union(tt.b); !slices.Equal(got, tt.want) {
+				t.Errorf("%s.Union(%s) got %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package daytime
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// RangeSet represents a set of daytimes as a sorted list of disjoint ranges
+// within [StartOfDay, EndOfDay].
+//
+// Ranges spanning midnight are split at midnight when added, so the set is
+// always stored as ranges that do not wrap. The zero value is an empty set.
+type RangeSet struct {
+	ranges []Range
+}
+
+// NewRangeSet creates a new set containing the daytimes of all given ranges.
+func NewRangeSet(ranges ...Range) RangeSet {
+	var pieces []Range
+	for _, r := range ranges {
+		pieces = append(pieces, r.Split()...)
+	}
+	return RangeSet{ranges: normalizeRanges(pieces)}
+}
+
+// FullDay returns a set containing every daytime [00:00:00-24:00:00].
+func FullDay() RangeSet {
+	return RangeSet{ranges: []Range{{start: StartOfDay, end: EndOfDay, bounds: Closed}}}
+}
+
+// Ranges returns the disjoint ranges of the set ordered by start.
+//
+// None of the returned ranges spans midnight.
+func (s RangeSet) Ranges() []Range {
+	return slices.Clone(s.ranges)
+}
+
+// Len returns the number of disjoint ranges in the set.
+func (s RangeSet) Len() int {
+	return len(s.ranges)
+}
+
+// IsEmpty reports whether the set contains no daytimes.
+func (s RangeSet) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Equal reports whether two sets contain the same daytimes.
+func (s RangeSet) Equal(other RangeSet) bool {
+	return slices.Equal(s.ranges, other.ranges)
+}
+
+// Add adds the daytimes of the range to the set.
+func (s *RangeSet) Add(r Range) {
+	s.ranges = normalizeRanges(slices.Concat(s.ranges, r.Split()))
+}
+
+// Remove removes the daytimes of the range from the set.
+func (s *RangeSet) Remove(r Range) {
+	s.ranges = intersectRanges(s.ranges, complementRanges(normalizeRanges(r.Split())))
+}
+
+// Contains reports whether the daytime belongs to the set.
+//
+// The lookup takes O(log n) time for a set of n ranges.
+func (s RangeSet) Contains(d Daytime) bool {
+	if !d.Valid() {
+		return false
+	}
+	i, found := slices.BinarySearchFunc(s.ranges, d, func(r Range, d Daytime) int {
+		return r.start.Compare(d)
+	})
+	if !found {
+		i--
+	}
+	return i >= 0 && s.ranges[i].containsLinear(d)
+}
+
+// TotalDuration returns the combined length of all ranges in the set.
+func (s RangeSet) TotalDuration() time.Duration {
+	var total time.Duration
+	for _, r := range s.ranges {
+		total += r.Duration()
+	}
+	return total
+}
+
+// Union returns the daytimes belonging to either set.
+func (s RangeSet) Union(other RangeSet) RangeSet {
+	return RangeSet{ranges: normalizeRanges(slices.Concat(s.ranges, other.ranges))}
+}
+
+// Intersect returns the daytimes belonging to both sets.
+func (s RangeSet) Intersect(other RangeSet) RangeSet {
+	return RangeSet{ranges: intersectRanges(s.ranges, other.ranges)}
+}
+
+// Difference returns the daytimes belonging to the set but not to the other.
+func (s RangeSet) Difference(other RangeSet) RangeSet {
+	return RangeSet{ranges: intersectRanges(s.ranges, complementRanges(other.ranges))}
+}
+
+// Complement returns the daytimes of [00:00:00-24:00:00] not belonging to the set.
+func (s RangeSet) Complement() RangeSet {
+	return RangeSet{ranges: complementRanges(s.ranges)}
+}
+
+// String returns the ranges of the set in braces, e.g. "{09:00:00-12:00:00, 13:00:00-17:00:00}".
+func (s RangeSet) String() string {
+	parts := make([]string, len(s.ranges))
+	for i, r := range s.ranges {
+		parts[i] = r.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// --- Helper functions ---
+
+// complementRanges returns the gaps of a normalized list of ranges within [StartOfDay, EndOfDay].
+func complementRanges(ranges []Range) []Range {
+	var gaps []Range
+	start, startIn := StartOfDay, true
+	for _, r := range ranges {
+		gap := Range{start: start, end: r.start, bounds: boundsOf(startIn, !r.IncludesStart())}
+		if !gap.IsEmpty() {
+			gaps = append(gaps, gap)
+		}
+		start, startIn = r.end, !r.IncludesEnd()
+	}
+
+	tail := Range{start: start, end: EndOfDay, bounds: boundsOf(startIn, true)}
+	if !tail.IsEmpty() {
+		gaps = append(gaps, tail)
+	}
+	return gaps
+}
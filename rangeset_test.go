@@ -0,0 +1,171 @@
+package daytime
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewRangeSet(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []Range
+		want   []Range
+	}{
+		{"Empty", nil, nil},
+		{
+			"Overlapping ranges are merged",
+			[]Range{MustRange(D060000, D120000, ClosedOpen), MustRange(D010000, Must(8, 0, 0), ClosedOpen)},
+			[]Range{MustRange(D010000, D120000, ClosedOpen)},
+		},
+		{
+			"Wraparound range is split at midnight",
+			[]Range{MustRange(Must(22, 0, 0), Must(2, 0, 0), ClosedOpen)},
+			[]Range{MustRange(D000000, Must(2, 0, 0), ClosedOpen), MustRange(Must(22, 0, 0), D240000, Closed)},
+		},
+		{
+			"Empty ranges are dropped",
+			[]Range{MustRange(D120000, D120000, ClosedOpen), MustRange(D010000, D060000, ClosedOpen)},
+			[]Range{MustRange(D010000, D060000, ClosedOpen)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRangeSet(tt.ranges...).Ranges(); !slices.Equal(got, tt.want) {
+				t.Errorf("NewRangeSet(%v).Ranges() got %v, want %v", tt.ranges, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeSet_AddAndRemove(t *testing.T) {
+	var s RangeSet
+	s.Add(MustRange(Must(9, 0, 0), D120000, ClosedOpen))
+	s.Add(MustRange(Must(13, 0, 0), Must(17, 0, 0), ClosedOpen))
+	s.Add(MustRange(D120000, Must(13, 0, 0), ClosedOpen))
+
+	want := []Range{MustRange(Must(9, 0, 0), Must(17, 0, 0), ClosedOpen)}
+	if got := s.Ranges(); !slices.Equal(got, want) {
+		t.Fatalf("after Add got %v, want %v", got, want)
+	}
+
+	s.Remove(MustRange(D120000, Must(13, 0, 0), ClosedOpen))
+	want = []Range{MustRange(Must(9, 0, 0), D120000, ClosedOpen), MustRange(Must(13, 0, 0), Must(17, 0, 0), ClosedOpen)}
+	if got := s.Ranges(); !slices.Equal(got, want) {
+		t.Fatalf("after Remove got %v, want %v", got, want)
+	}
+
+	s.Remove(MustRange(Must(16, 0, 0), Must(10, 0, 0), ClosedOpen))
+	want = []Range{MustRange(Must(10, 0, 0), D120000, ClosedOpen), MustRange(Must(13, 0, 0), Must(16, 0, 0), ClosedOpen)}
+	if got := s.Ranges(); !slices.Equal(got, want) {
+		t.Errorf("after wraparound Remove got %v, want %v", got, want)
+	}
+}
+
+func TestRangeSet_Contains(t *testing.T) {
+	s := NewRangeSet(
+		MustRange(Must(9, 0, 0), D120000, ClosedOpen),
+		MustRange(Must(13, 0, 0), Must(17, 0, 0), Closed),
+		MustRange(Must(22, 0, 0), Must(2, 0, 0), ClosedOpen),
+	)
+
+	tests := []struct {
+		name string
+		d    Daytime
+		want bool
+	}{
+		{"Before first range", Must(8, 59, 59), false},
+		{"At included start", Must(9, 0, 0), true},
+		{"At excluded end", D120000, false},
+		{"Between ranges", Must(12, 30, 0), false},
+		{"At included end", Must(17, 0, 0), true},
+		{"Wraparound: StartOfDay", D000000, true},
+		{"Wraparound: EndOfDay", D240000, true},
+		{"Wraparound: At excluded end", Must(2, 0, 0), false},
+		{"Invalid daytime", DInvalid, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Contains(tt.d); got != tt.want {
+				t.Errorf("%s.Contains(%s) got %t, want %t", s, tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeSet_Algebra(t *testing.T) {
+	opening := NewRangeSet(MustRange(Must(9, 0, 0), Must(18, 0, 0), ClosedOpen))
+	meetings := NewRangeSet(
+		MustRange(Must(10, 0, 0), Must(11, 0, 0), ClosedOpen),
+		MustRange(Must(14, 0, 0), Must(15, 30, 0), ClosedOpen),
+	)
+
+	t.Run("Difference yields free slots", func(t *testing.T) {
+		got := opening.Difference(meetings)
+		want := NewRangeSet(
+			MustRange(Must(9, 0, 0), Must(10, 0, 0), ClosedOpen),
+			MustRange(Must(11, 0, 0), Must(14, 0, 0), ClosedOpen),
+			MustRange(Must(15, 30, 0), Must(18, 0, 0), ClosedOpen),
+		)
+		if !got.Equal(want) {
+			t.Errorf("Difference got %s, want %s", got, want)
+		}
+		if dur := got.TotalDuration(); dur != 6*time.Hour+30*time.Minute {
+			t.Errorf("TotalDuration got %v, want 6h30m", dur)
+		}
+	})
+
+	t.Run("Intersect", func(t *testing.T) {
+		if got := opening.Intersect(meetings); !got.Equal(meetings) {
+			t.Errorf("Intersect got %s, want %s", got, meetings)
+		}
+	})
+
+	t.Run("Union", func(t *testing.T) {
+		night := NewRangeSet(MustRange(Must(22, 0, 0), Must(9, 0, 0), ClosedOpen))
+		got := opening.Union(night)
+		want := NewRangeSet(MustRange(Must(22, 0, 0), Must(18, 0, 0), ClosedOpen))
+		if !got.Equal(want) {
+			t.Errorf("Union got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("Complement", func(t *testing.T) {
+		got := opening.Complement()
+		want := NewRangeSet(MustRange(Must(18, 0, 0), Must(9, 0, 0), ClosedOpen))
+		if !got.Equal(want) {
+			t.Errorf("Complement got %s, want %s", got, want)
+		}
+		if !got.Complement().Equal(opening) {
+			t.Errorf("double Complement got %s, want %s", got.Complement(), opening)
+		}
+	})
+
+	t.Run("Complement of empty set is full day", func(t *testing.T) {
+		var empty RangeSet
+		if got := empty.Complement(); !got.Equal(FullDay()) {
+			t.Errorf("Complement got %s, want %s", got, FullDay())
+		}
+		if got := FullDay().Complement(); !got.IsEmpty() {
+			t.Errorf("FullDay().Complement() got %s, want empty", got)
+		}
+	})
+
+	t.Run("Complement keeps excluded points", func(t *testing.T) {
+		s := NewRangeSet(MustRange(D000000, D240000, ClosedOpen))
+		want := NewRangeSet(MustRange(D240000, D240000, Closed))
+		if got := s.Complement(); !got.Equal(want) {
+			t.Errorf("Complement got %s, want %s", got, want)
+		}
+	})
+}
+
+func TestRangeSet_String(t *testing.T) {
+	s := NewRangeSet(MustRange(Must(13, 0, 0), Must(17, 0, 0), ClosedOpen), MustRange(Must(9, 0, 0), D120000, ClosedOpen))
+	want := "{09:00:00-12:00:00, 13:00:00-17:00:00}"
+	if got := s.String(); got != want {
+		t.Errorf("RangeSet.String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,97 @@
+package daytime
+
+import "time"
+
+// Grid describes evenly spaced daytimes that Snap moves a daytime onto.
+//
+// The grid points are Origin plus any whole number of Steps. They continue across
+// midnight, so a point past the end of the day lies on the next day and one before
+// the start of the day on the previous day.
+type Grid struct {
+	// Step is the spacing of the grid, truncated to whole seconds.
+	Step time.Duration
+
+	// Origin is a grid point, e.g. 00:10:00 for slots at 10, 25, 40 and 55 minutes past the hour.
+	Origin Daytime
+
+	// Rounding selects the grid point before, nearest to, or after the daytime.
+	Rounding Rounding
+
+	// WrapMidnight reports a result at midnight after the day as 00:00:00 with one day
+	// carried instead of EndOfDay.
+	WrapMidnight bool
+}
+
+// Truncate returns the daytime rounded down to a multiple of step since midnight.
+//
+// EndOfDay is kept if step divides the day evenly. The step is truncated to whole seconds;
+// the daytime is returned unchanged if the step is shorter than a second or the daytime is invalid.
+func (d Daytime) Truncate(step time.Duration) Daytime {
+	result, _ := d.Snap(Grid{Step: step, Rounding: RoundDown})
+	return result
+}
+
+// Round returns the daytime rounded to the nearest multiple of step since midnight,
+// with halfway values rounded up.
+//
+// Returns the result and the number of day boundaries crossed, following the same rules
+// as Add: rounding up to midnight yields EndOfDay with zero days (e.g. 23:59:50 rounded
+// to a minute), and only steps that do not divide the day evenly can carry into the next day.
+// Use Snap with WrapMidnight to get 00:00:00 of the next day instead.
+// The step is truncated to whole seconds; the daytime is returned unchanged if the step
+// is shorter than a second or the daytime is invalid.
+func (d Daytime) Round(step time.Duration) (Daytime, int) {
+	return d.Snap(Grid{Step: step, Rounding: RoundNearest})
+}
+
+// Ceil returns the daytime rounded up to a multiple of step since midnight.
+//
+// Returns the result and the number of day boundaries crossed, following the same rules as Round.
+func (d Daytime) Ceil(step time.Duration) (Daytime, int) {
+	return d.Snap(Grid{Step: step, Rounding: RoundUp})
+}
+
+// Snap moves the daytime onto the grid according to its rounding mode.
+//
+// Returns the grid point and the number of day boundaries crossed, following the same
+// rules as Add unless the grid wraps at midnight. A daytime already on the grid is
+// returned as is; EndOfDay is treated as midnight at the end of its day.
+// The daytime is returned unchanged if the grid step is shorter than a second,
+// the grid origin is invalid, or the daytime is invalid.
+func (d Daytime) Snap(grid Grid) (Daytime, int) {
+	step := int(grid.Step / time.Second)
+	if step <= 0 || !d.Valid() || !grid.Origin.Valid() {
+		return d, 0
+	}
+
+	offset := int(d) - int(grid.Origin)
+	below := int(grid.Origin) + floorDiv(offset, step)*step
+	point := below
+	switch grid.Rounding {
+	case RoundNearest:
+		if 2*(int(d)-below) >= step {
+			point += step
+		}
+	case RoundUp:
+		if below != int(d) {
+			point += step
+		}
+	}
+
+	result, days := StartOfDay.Add(point)
+	if grid.WrapMidnight && result == EndOfDay {
+		return StartOfDay, days + 1
+	}
+	return result, days
+}
+
+// --- Helper functions ---
+
+// floorDiv returns a/b rounded toward negative infinity for positive b.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
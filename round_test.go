@@ -0,0 +1,99 @@
+package daytime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDaytime_Truncate(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Daytime
+		step time.Duration
+		want Daytime
+	}{
+		{"Quarter hour", Must(9, 14, 59), 15 * time.Minute, Must(9, 0, 0)},
+		{"On the grid", Must(9, 15, 0), 15 * time.Minute, Must(9, 15, 0)},
+		{"Hour", D123045, time.Hour, D120000},
+		{"EndOfDay kept for dividing step", D240000, 15 * time.Minute, D240000},
+		{"EndOfDay with non-dividing step", D240000, 7 * time.Hour, Must(21, 0, 0)},
+		{"Sub-second part of step is ignored", Must(0, 0, 5), 2500 * time.Millisecond, Must(0, 0, 4)},
+		{"Sub-second step", D123045, time.Millisecond, D123045},
+		{"Negative step", D123045, -time.Hour, D123045},
+		{"Invalid daytime", DInvalid, time.Hour, DInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Truncate(tt.step); got != tt.want {
+				t.Errorf("Truncate() got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaytime_RoundCeil(t *testing.T) {
+	tests := []struct {
+		name      string
+		d         Daytime
+		step      time.Duration
+		wantRound Daytime
+		roundDays int
+		wantCeil  Daytime
+		ceilDays  int
+	}{
+		{"Below half", Must(9, 7, 0), 15 * time.Minute, Must(9, 0, 0), 0, Must(9, 15, 0), 0},
+		{"Exactly half rounds up", Must(9, 7, 30), 15 * time.Minute, Must(9, 15, 0), 0, Must(9, 15, 0), 0},
+		{"On the grid", Must(9, 30, 0), 30 * time.Minute, Must(9, 30, 0), 0, Must(9, 30, 0), 0},
+		{"Up to EndOfDay", Must(23, 59, 50), time.Minute, D240000, 0, D240000, 0},
+		{"Down from end of day", Must(23, 52, 0), 15 * time.Minute, Must(23, 45, 0), 0, D240000, 0},
+		{"Start of day", D000000, 5 * time.Minute, D000000, 0, D000000, 0},
+		{"EndOfDay stays", D240000, 5 * time.Minute, D240000, 0, D240000, 0},
+		{"Non-dividing step carries", Must(22, 0, 0), 7 * time.Hour, Must(21, 0, 0), 0, Must(4, 0, 0), 1},
+		{"Sub-second step", D123045, time.Millisecond, D123045, 0, D123045, 0},
+		{"Invalid daytime", DInvalid, time.Minute, DInvalid, 0, DInvalid, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, days := tt.d.Round(tt.step); got != tt.wantRound || days != tt.roundDays {
+				t.Errorf("Round() got (%s, %d), want (%s, %d)", got, days, tt.wantRound, tt.roundDays)
+			}
+			if got, days := tt.d.Ceil(tt.step); got != tt.wantCeil || days != tt.ceilDays {
+				t.Errorf("Ceil() got (%s, %d), want (%s, %d)", got, days, tt.wantCeil, tt.ceilDays)
+			}
+		})
+	}
+}
+
+func TestDaytime_Snap(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Daytime
+		grid Grid
+		want Daytime
+		days int
+	}{
+		{"Down", Must(10, 29, 59), Grid{Step: 30 * time.Minute}, Must(10, 0, 0), 0},
+		{"Nearest", Must(10, 16, 0), Grid{Step: 30 * time.Minute, Rounding: RoundNearest}, Must(10, 30, 0), 0},
+		{"Up", Must(10, 0, 1), Grid{Step: 5 * time.Minute, Rounding: RoundUp}, Must(10, 5, 0), 0},
+		{"Origin offsets the grid", Must(10, 20, 0), Grid{Step: 15 * time.Minute, Origin: Must(0, 10, 0)}, Must(10, 10, 0), 0},
+		{"Origin after daytime", Must(10, 20, 0), Grid{Step: 15 * time.Minute, Origin: D180000, Rounding: RoundUp}, Must(10, 30, 0), 0},
+		{"Down into previous day", Must(0, 5, 0), Grid{Step: 15 * time.Minute, Origin: Must(0, 10, 0)}, Must(23, 55, 0), -1},
+		{"Up into next day", Must(23, 58, 0), Grid{Step: 15 * time.Minute, Origin: Must(0, 10, 0), Rounding: RoundUp}, Must(0, 10, 0), 1},
+		{"EndOfDay by default", Must(23, 59, 50), Grid{Step: time.Minute, Rounding: RoundNearest}, D240000, 0},
+		{"Wrap midnight", Must(23, 59, 50), Grid{Step: time.Minute, Rounding: RoundNearest, WrapMidnight: true}, D000000, 1},
+		{"Wrap midnight keeps start of day", D000000, Grid{Step: time.Minute, WrapMidnight: true}, D000000, 0},
+		{"Invalid origin", D123045, Grid{Step: time.Hour, Origin: DInvalid}, D123045, 0},
+		{"Zero grid", D123045, Grid{}, D123045, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, days := tt.d.Snap(tt.grid)
+			if got != tt.want || days != tt.days {
+				t.Errorf("Snap() got (%s, %d), want (%s, %d)", got, days, tt.want, tt.days)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package daytime
+
+import (
+	"slices"
+	"time"
+)
+
+// WeeklySchedule maps each day of the week to the ranges of daytimes when it is open,
+// such as store opening hours.
+//
+// A range spanning midnight opens on its own weekday and closes on the following one,
+// so Friday 22:00:00-02:00:00 stays open until 02:00:00 on Saturday.
+// A range closed at EndOfDay (24:00:00) is also open at the instant 00:00:00 of the next day.
+//
+// Times passed to the schedule are evaluated in their own location.
+// The zero value is a schedule that is never open.
+type WeeklySchedule struct {
+	days [7][]Range
+}
+
+// Add adds ranges to the given day of the week.
+//
+// Returns ErrValueOutOfRange if day is not a valid weekday.
+func (s *WeeklySchedule) Add(day time.Weekday, ranges ...Range) error {
+	if day < time.Sunday || day > time.Saturday {
+		return errorf("Add", day, ErrValueOutOfRange)
+	}
+	s.days[day] = append(s.days[day], ranges...)
+	return nil
+}
+
+// Set replaces the ranges of the given day of the week.
+//
+// Returns ErrValueOutOfRange if day is not a valid weekday.
+func (s *WeeklySchedule) Set(day time.Weekday, ranges ...Range) error {
+	if day < time.Sunday || day > time.Saturday {
+		return errorf("Set", day, ErrValueOutOfRange)
+	}
+	s.days[day] = slices.Clone(ranges)
+	return nil
+}
+
+// Day returns the ranges configured for the given day of the week.
+func (s *WeeklySchedule) Day(day time.Weekday) []Range {
+	if day < time.Sunday || day > time.Saturday {
+		return nil
+	}
+	return slices.Clone(s.days[day])
+}
+
+// OpenSet returns the daytimes when the schedule is open on the given day of the week,
+// including the part of the previous day's ranges spilling over midnight.
+func (s *WeeklySchedule) OpenSet(day time.Weekday) RangeSet {
+	if day < time.Sunday || day > time.Saturday {
+		return RangeSet{}
+	}
+	return openSet(s.days[day], s.days[(day+6)%7])
+}
+
+// IsOpen reports whether the schedule is open at t.
+func (s *WeeklySchedule) IsOpen(t time.Time) bool {
+	return isOpen(s.rangesOn, t)
+}
+
+// NextOpen returns the earliest instant at or after t when the schedule is open.
+//
+// Returns t itself if the schedule is already open, and false if it never opens.
+// A range excluding its start opens one second after it, at the first daytime it contains.
+func (s *WeeklySchedule) NextOpen(t time.Time) (time.Time, bool) {
+	return nextOpen(s.rangesOn, t)
+}
+
+// NextClose returns the earliest instant at or after t when the schedule is closed.
+//
+// Returns t itself if the schedule is already closed, and false if it never closes.
+// A range including its end closes one second after it, at the first daytime it excludes.
+func (s *WeeklySchedule) NextClose(t time.Time) (time.Time, bool) {
+	return nextClose(s.rangesOn, t)
+}
+
+// OpenDurationBetween returns how long the schedule is open between t1 and t2.
+//
+// Returns zero if t2 is not after t1.
+func (s *WeeklySchedule) OpenDurationBetween(t1, t2 time.Time) time.Duration {
+	return openDurationBetween(s.rangesOn, t1, t2)
+}
+
+// rangesOn returns the ranges opening on the given date.
+func (s *WeeklySchedule) rangesOn(date time.Time) []Range {
+	return s.days[date.Weekday()]
+}
+
+// --- Helper functions ---
+
+// interval is an absolute time interval whose endpoints are included as flagged.
+type interval struct {
+	start   time.Time
+	end     time.Time
+	startIn bool
+	endIn   bool
+}
+
+// contains reports whether the interval contains the instant t.
+func (iv interval) contains(t time.Time) bool {
+	return t.After(iv.start) && t.Before(iv.end) ||
+		t.Equal(iv.start) && iv.startIn ||
+		t.Equal(iv.end) && iv.endIn
+}
+
+// opening returns the first instant of the interval at the one-second resolution of daytimes,
+// which is one second after an excluded start. Returns false if there is none.
+func (iv interval) opening() (time.Time, bool) {
+	if iv.startIn {
+		return iv.start, true
+	}
+	t := iv.start.Add(time.Second)
+	return t, iv.contains(t)
+}
+
+// closing returns the first instant after the interval at the one-second resolution of daytimes,
+// which is one second after an included end.
+func (iv interval) closing() time.Time {
+	if iv.endIn {
+		return iv.end.Add(time.Second)
+	}
+	return iv.end
+}
+
+// openSet returns the daytimes covered on a day by its own ranges
+// and the ranges of the previous day spilling over midnight.
+func openSet(today, yesterday []Range) RangeSet {
+	var pieces []Range
+	for _, r := range today {
+		if !r.Wraps() {
+			pieces = append(pieces, r)
+			continue
+		}
+		pieces = append(pieces, r.Split()[0])
+	}
+	for _, r := range yesterday {
+		if !r.Wraps() {
+			continue
+		}
+		if split := r.Split(); len(split) > 1 {
+			pieces = append(pieces, split[1])
+		}
+	}
+	return NewRangeSet(pieces...)
+}
+
+// startOfDate returns midnight of the date of t in its location.
+func startOfDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// rangeInterval converts a range opening on date into an absolute interval.
+//
+// The interval opens at the start and closes at the end, whichever endpoints the range
+// includes, so its length matches Range.Duration. Ranges spanning midnight close on the next date.
+func rangeInterval(r Range, date time.Time) interval {
+	endDate := date
+	if r.Wraps() {
+		endDate = date.AddDate(0, 0, 1)
+	}
+	return interval{
+		start:   r.start.Time(date),
+		end:     r.end.Time(endDate),
+		startIn: r.IncludesStart(),
+		endIn:   r.IncludesEnd(),
+	}
+}
+
+// openIntervals returns the merged open intervals of ranges opening on the dates from first to last.
+//
+// Intervals sharing an endpoint are merged only if one of them includes it.
+func openIntervals(rangesOn func(date time.Time) []Range, first, last time.Time) []interval {
+	var intervals []interval
+	for date := startOfDate(first); !date.After(last); date = date.AddDate(0, 0, 1) {
+		for _, r := range rangesOn(date) {
+			if r.IsEmpty() {
+				continue
+			}
+			intervals = append(intervals, rangeInterval(r, date))
+		}
+	}
+
+	slices.SortFunc(intervals, func(a, b interval) int {
+		return a.start.Compare(b.start)
+	})
+
+	var merged []interval
+	for _, iv := range intervals {
+		if iv.end.Before(iv.start) || iv.end.Equal(iv.start) && !(iv.startIn && iv.endIn) {
+			continue
+		}
+		n := len(merged)
+		if n == 0 {
+			merged = append(merged, iv)
+			continue
+		}
+		last := &merged[n-1]
+		if iv.start.After(last.end) || iv.start.Equal(last.end) && !last.endIn && !iv.startIn {
+			merged = append(merged, iv)
+			continue
+		}
+		if iv.start.Equal(last.start) {
+			last.startIn = last.startIn || iv.startIn
+		}
+		switch {
+		case iv.end.After(last.end):
+			last.end, last.endIn = iv.end, iv.endIn
+		case iv.end.Equal(last.end):
+			last.endIn = last.endIn || iv.endIn
+		}
+	}
+	return merged
+}
+
+// isOpen reports whether any range is open at t.
+//
+// The instants of the endpoints count as open only if the range includes them.
+func isOpen(rangesOn func(date time.Time) []Range, t time.Time) bool {
+	for _, iv := range openIntervals(rangesOn, t.AddDate(0, 0, -1), t) {
+		if iv.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchDays is the number of days scanned ahead by nextOpen and nextClose.
+// It covers a full week together with ranges spilling over midnight.
+const searchDays = 8
+
+// nextOpen returns the earliest instant at or after t when any range is open.
+func nextOpen(rangesOn func(date time.Time) []Range, t time.Time) (time.Time, bool) {
+	for _, iv := range openIntervals(rangesOn, t.AddDate(0, 0, -1), t.AddDate(0, 0, searchDays)) {
+		if iv.contains(t) {
+			return t, true
+		}
+		if opening, ok := iv.opening(); ok && opening.After(t) {
+			return opening, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// nextClose returns the earliest instant at or after t when no range is open.
+func nextClose(rangesOn func(date time.Time) []Range, t time.Time) (time.Time, bool) {
+	horizon := startOfDate(t.AddDate(0, 0, searchDays))
+	closed := t
+	for _, iv := range openIntervals(rangesOn, t.AddDate(0, 0, -1), horizon) {
+		if iv.start.After(closed) {
+			break
+		}
+		if !iv.contains(closed) {
+			continue
+		}
+		// The next interval may reopen within the second after an included end.
+		closed = iv.closing()
+		if closed.After(horizon) {
+			// Open for more than a full week without a break.
+			return time.Time{}, false
+		}
+	}
+	return closed, true
+}
+
+// openDurationBetween returns the total open time between t1 and t2.
+func openDurationBetween(rangesOn func(date time.Time) []Range, t1, t2 time.Time) time.Duration {
+	if !t2.After(t1) {
+		return 0
+	}
+
+	var total time.Duration
+	for _, iv := range openIntervals(rangesOn, t1.AddDate(0, 0, -1), t2) {
+		start, end := iv.start, iv.end
+		if start.Before(t1) {
+			start = t1
+		}
+		if end.After(t2) {
+			end = t2
+		}
+		if end.After(start) {
+			total += end.Sub(start)
+		}
+	}
+	return total
+}
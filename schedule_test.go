@@ -0,0 +1,279 @@
+package daytime
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// testSchedule returns a schedule open Monday-Friday 09:00-18:00,
+// Friday night 22:00-02:00, and Saturday 10:00-24:00.
+func testSchedule(t *testing.T) *WeeklySchedule {
+	t.Helper()
+
+	var s WeeklySchedule
+	for day := time.Monday; day <= time.Friday; day++ {
+		if err := s.Add(day, MustRange(Must(9, 0, 0), D180000, ClosedOpen)); err != nil {
+			t.Fatalf("Add(%s) got unexpected error: %v", day, err)
+		}
+	}
+	if err := s.Add(time.Friday, MustRange(Must(22, 0, 0), Must(2, 0, 0), ClosedOpen)); err != nil {
+		t.Fatalf("Add(Friday) got unexpected error: %v", err)
+	}
+	if err := s.Add(time.Saturday, MustRange(Must(10, 0, 0), D240000, ClosedOpen)); err != nil {
+		t.Fatalf("Add(Saturday) got unexpected error: %v", err)
+	}
+	return &s
+}
+
+// 2025-01-06 is a Monday.
+func testDate(day, hour, minute int) time.Time {
+	return time.Date(2025, time.January, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestWeeklySchedule_AddAndSet(t *testing.T) {
+	var s WeeklySchedule
+	if err := s.Add(time.Weekday(7), MustRange(D010000, D060000, ClosedOpen)); !errors.Is(err, ErrValueOutOfRange) {
+		t.Errorf("Add(7) got error %v, want %v", err, ErrValueOutOfRange)
+	}
+	if err := s.Set(time.Weekday(-1)); !errors.Is(err, ErrValueOutOfRange) {
+		t.Errorf("Set(-1) got error %v, want %v", err, ErrValueOutOfRange)
+	}
+
+	r := MustRange(D010000, D060000, ClosedOpen)
+	_ = s.Add(time.Monday, r)
+	_ = s.Add(time.Monday, r)
+	if got := s.Day(time.Monday); len(got) != 2 {
+		t.Errorf("Day(Monday) after two Add got %v, want 2 ranges", got)
+	}
+	_ = s.Set(time.Monday, r)
+	if got := s.Day(time.Monday); !slices.Equal(got, []Range{r}) {
+		t.Errorf("Day(Monday) after Set got %v, want %v", got, []Range{r})
+	}
+}
+
+func TestWeeklySchedule_OpenSet(t *testing.T) {
+	s := testSchedule(t)
+
+	want := NewRangeSet(MustRange(D000000, Must(2, 0, 0), ClosedOpen), MustRange(Must(10, 0, 0), D240000, ClosedOpen))
+	if got := s.OpenSet(time.Saturday); !got.Equal(want) {
+		t.Errorf("OpenSet(Saturday) got %s, want %s", got, want)
+	}
+}
+
+func TestWeeklySchedule_IsOpen(t *testing.T) {
+	s := testSchedule(t)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{"Monday before opening", testDate(6, 8, 59), false},
+		{"Monday at opening", testDate(6, 9, 0), true},
+		{"Monday at closing", testDate(6, 18, 0), false},
+		{"Friday night", testDate(10, 23, 0), true},
+		{"Saturday after midnight (spill from Friday)", testDate(11, 1, 30), true},
+		{"Saturday at spill end", testDate(11, 2, 0), false},
+		{"Saturday late evening", testDate(11, 23, 59), true},
+		{"Sunday midnight after half-open EndOfDay", testDate(12, 0, 0), false},
+		{"Sunday", testDate(12, 12, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.IsOpen(tt.t); got != tt.want {
+				t.Errorf("IsOpen(%s) got %t, want %t", tt.t.Format(time.DateTime), got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Closed EndOfDay includes next midnight", func(t *testing.T) {
+		var s WeeklySchedule
+		_ = s.Add(time.Monday, MustRange(D180000, D240000, Closed))
+		if !s.IsOpen(testDate(7, 0, 0)) {
+			t.Errorf("IsOpen(Tuesday 00:00:00) got false, want true")
+		}
+		if s.IsOpen(testDate(7, 0, 1)) {
+			t.Errorf("IsOpen(Tuesday 00:01:00) got true, want false")
+		}
+	})
+
+	t.Run("Endpoint instants follow the bounds", func(t *testing.T) {
+		var s WeeklySchedule
+		_ = s.Add(time.Monday, MustRange(Must(9, 0, 0), Must(12, 0, 0), Open), MustRange(Must(14, 0, 0), Must(17, 0, 0), Closed))
+		tests := []struct {
+			t    time.Time
+			want bool
+		}{
+			{testDate(6, 9, 0), false},
+			{testDate(6, 9, 0).Add(time.Millisecond), true},
+			{testDate(6, 12, 0), false},
+			{testDate(6, 14, 0), true},
+			{testDate(6, 17, 0), true},
+			{testDate(6, 17, 0).Add(500 * time.Millisecond), false},
+		}
+		for _, tt := range tests {
+			if got := s.IsOpen(tt.t); got != tt.want {
+				t.Errorf("IsOpen(%s) got %t, want %t", tt.t.Format(time.StampMilli), got, tt.want)
+			}
+		}
+	})
+}
+
+func TestWeeklySchedule_NextOpenAndClose(t *testing.T) {
+	s := testSchedule(t)
+
+	tests := []struct {
+		name      string
+		t         time.Time
+		wantOpen  time.Time
+		wantClose time.Time
+	}{
+		{"Monday early morning", testDate(6, 7, 0), testDate(6, 9, 0), testDate(6, 7, 0)},
+		{"Monday during hours", testDate(6, 12, 0), testDate(6, 12, 0), testDate(6, 18, 0)},
+		{"Friday night runs into Saturday", testDate(10, 23, 0), testDate(10, 23, 0), testDate(11, 2, 0)},
+		{"Saturday evening runs through midnight", testDate(11, 20, 0), testDate(11, 20, 0), testDate(12, 0, 0)},
+		{"Sunday waits for Monday", testDate(12, 12, 0), testDate(13, 9, 0), testDate(12, 12, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOpen, ok := s.NextOpen(tt.t)
+			if !ok || !gotOpen.Equal(tt.wantOpen) {
+				t.Errorf("NextOpen(%s) got (%s, %t), want %s", tt.t.Format(time.DateTime), gotOpen.Format(time.DateTime), ok, tt.wantOpen.Format(time.DateTime))
+			}
+			gotClose, ok := s.NextClose(tt.t)
+			if !ok || !gotClose.Equal(tt.wantClose) {
+				t.Errorf("NextClose(%s) got (%s, %t), want %s", tt.t.Format(time.DateTime), gotClose.Format(time.DateTime), ok, tt.wantClose.Format(time.DateTime))
+			}
+		})
+	}
+
+	t.Run("Endpoint bounds", func(t *testing.T) {
+		closed, open, split := new(WeeklySchedule), new(WeeklySchedule), new(WeeklySchedule)
+		_ = closed.Add(time.Monday, MustRange(Must(9, 0, 0), Must(17, 0, 0), Closed))
+		_ = open.Add(time.Monday, MustRange(Must(9, 0, 0), Must(17, 0, 0), Open))
+		_ = split.Add(time.Monday, MustRange(Must(9, 0, 0), Must(12, 0, 0), ClosedOpen), MustRange(Must(12, 0, 0), Must(17, 0, 0), Open))
+
+		tests := []struct {
+			name      string
+			s         *WeeklySchedule
+			t         time.Time
+			wantOpen  time.Time
+			wantClose time.Time
+		}{
+			{"Closed range at its end", closed, testDate(6, 17, 0), testDate(6, 17, 0), testDate(6, 17, 0).Add(time.Second)},
+			{"Closed range at its start", closed, testDate(6, 9, 0), testDate(6, 9, 0), testDate(6, 17, 0).Add(time.Second)},
+			{"Open range at its start", open, testDate(6, 9, 0), testDate(6, 9, 0).Add(time.Second), testDate(6, 9, 0)},
+			{"Open range inside", open, testDate(6, 10, 0), testDate(6, 10, 0), testDate(6, 17, 0)},
+			{"Gap between excluded endpoints", split, testDate(6, 10, 0), testDate(6, 10, 0), testDate(6, 12, 0)},
+			{"At the gap", split, testDate(6, 12, 0), testDate(6, 12, 0).Add(time.Second), testDate(6, 12, 0)},
+		}
+		for _, tt := range tests {
+			if got, ok := tt.s.NextOpen(tt.t); !ok || !got.Equal(tt.wantOpen) {
+				t.Errorf("%s: NextOpen(%s) got (%s, %t), want %s", tt.name, tt.t.Format(time.DateTime), got.Format(time.DateTime), ok, tt.wantOpen.Format(time.DateTime))
+			}
+			if got, ok := tt.s.NextClose(tt.t); !ok || !got.Equal(tt.wantClose) {
+				t.Errorf("%s: NextClose(%s) got (%s, %t), want %s", tt.name, tt.t.Format(time.DateTime), got.Format(time.DateTime), ok, tt.wantClose.Format(time.DateTime))
+			}
+			if got, _ := tt.s.NextOpen(tt.t); !tt.s.IsOpen(got) {
+				t.Errorf("%s: IsOpen(NextOpen(%s)) got false, want true", tt.name, tt.t.Format(time.DateTime))
+			}
+			if got, _ := tt.s.NextClose(tt.t); tt.s.IsOpen(got) {
+				t.Errorf("%s: IsOpen(NextClose(%s)) got true, want false", tt.name, tt.t.Format(time.DateTime))
+			}
+		}
+	})
+
+	t.Run("Touching ranges with an included endpoint", func(t *testing.T) {
+		var s WeeklySchedule
+		_ = s.Add(time.Monday, MustRange(Must(9, 0, 0), Must(12, 0, 0), ClosedOpen), MustRange(Must(12, 0, 0), Must(17, 0, 0), ClosedOpen))
+		if got, _ := s.NextClose(testDate(6, 10, 0)); !got.Equal(testDate(6, 17, 0)) {
+			t.Errorf("NextClose(Monday 10:00:00) got %s, want Monday 17:00:00", got.Format(time.DateTime))
+		}
+	})
+
+	t.Run("Never open", func(t *testing.T) {
+		var s WeeklySchedule
+		if _, ok := s.NextOpen(testDate(6, 12, 0)); ok {
+			t.Errorf("NextOpen on empty schedule got ok, want false")
+		}
+	})
+
+	t.Run("Always open", func(t *testing.T) {
+		var s WeeklySchedule
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			_ = s.Add(day, MustRange(D000000, D240000, ClosedOpen))
+		}
+		if _, ok := s.NextClose(testDate(6, 12, 0)); ok {
+			t.Errorf("NextClose on 24/7 schedule got ok, want false")
+		}
+	})
+}
+
+func TestWeeklySchedule_OpenDurationBetween(t *testing.T) {
+	s := testSchedule(t)
+
+	tests := []struct {
+		name string
+		t1   time.Time
+		t2   time.Time
+		want time.Duration
+	}{
+		{"Inside a single range", testDate(6, 10, 0), testDate(6, 12, 0), 2 * time.Hour},
+		{"Across a closed night", testDate(6, 17, 0), testDate(7, 10, 0), 2 * time.Hour},
+		{"Friday night spill", testDate(10, 20, 0), testDate(11, 3, 0), 4 * time.Hour},
+		{"Whole week", testDate(6, 0, 0), testDate(13, 0, 0), 5*9*time.Hour + 4*time.Hour + 14*time.Hour},
+		{"Reversed interval", testDate(6, 12, 0), testDate(6, 10, 0), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.OpenDurationBetween(tt.t1, tt.t2); got != tt.want {
+				t.Errorf("OpenDurationBetween(%s, %s) got %v, want %v",
+					tt.t1.Format(time.DateTime), tt.t2.Format(time.DateTime), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeeklySchedule_OpenDurationBetween_MatchesOpenSet(t *testing.T) {
+	for _, bounds := range []Bounds{ClosedOpen, Closed, OpenClosed, Open} {
+		var s WeeklySchedule
+		_ = s.Add(time.Monday, MustRange(Must(9, 0, 0), Must(17, 0, 0), bounds))
+		_ = s.Add(time.Tuesday, MustRange(D180000, D240000, bounds))
+
+		for day := 6; day <= 7; day++ {
+			weekday := testDate(day, 0, 0).Weekday()
+			want := s.OpenSet(weekday).TotalDuration()
+			if got := s.OpenDurationBetween(testDate(day, 0, 0), testDate(day+1, 0, 0)); got != want {
+				t.Errorf("OpenDurationBetween(%s) with bounds %v got %v, want OpenSet total %v", weekday, bounds, got, want)
+			}
+		}
+	}
+}
+
+func TestWeeklySchedule_DST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Failed to load location Europe/Berlin: %v", err)
+	}
+
+	// 2025-03-30 is a Sunday; clocks jump from 02:00 to 03:00 in Berlin.
+	var s WeeklySchedule
+	_ = s.Add(time.Saturday, MustRange(Must(22, 0, 0), Must(4, 0, 0), ClosedOpen))
+
+	from := time.Date(2025, time.March, 29, 21, 0, 0, 0, berlin)
+	to := time.Date(2025, time.March, 30, 5, 0, 0, 0, berlin)
+	if got := s.OpenDurationBetween(from, to); got != 5*time.Hour {
+		t.Errorf("OpenDurationBetween across spring-forward got %v, want 5h", got)
+	}
+
+	closeAt, ok := s.NextClose(time.Date(2025, time.March, 29, 23, 0, 0, 0, berlin))
+	want := time.Date(2025, time.March, 30, 4, 0, 0, 0, berlin)
+	if !ok || !closeAt.Equal(want) {
+		t.Errorf("NextClose across spring-forward got (%s, %t), want %s", closeAt, ok, want)
+	}
+}
@@ -0,0 +1,284 @@
+package daytime
+
+import (
+	"math"
+	"time"
+)
+
+// Solar calculations follow the NOAA solar calculator, which is based on
+// "Astronomical Algorithms" by Jean Meeus, and are accurate to about a minute
+// for latitudes between the polar circles.
+
+// Twilight selects the depth of the sun below the horizon for Dawn and Dusk.
+type Twilight uint8
+
+const (
+	// Civil twilight begins and ends with the sun 6° below the horizon.
+	Civil Twilight = iota
+
+	// Nautical twilight begins and ends with the sun 12° below the horizon.
+	Nautical
+
+	// Astronomical twilight begins and ends with the sun 18° below the horizon.
+	Astronomical
+)
+
+// Sun elevations in degrees at which solar events happen.
+const (
+	// sunriseElevation accounts for atmospheric refraction and the radius of the solar disc.
+	sunriseElevation = -0.833
+
+	civilElevation        = -6.0
+	nauticalElevation     = -12.0
+	astronomicalElevation = -18.0
+)
+
+// elevation returns the sun elevation of the twilight in degrees.
+func (tw Twilight) elevation() (float64, bool) {
+	switch tw {
+	case Civil:
+		return civilElevation, true
+	case Nautical:
+		return nauticalElevation, true
+	case Astronomical:
+		return astronomicalElevation, true
+	default:
+		return 0, false
+	}
+}
+
+// Observer is a position on Earth for which solar events are computed.
+type Observer struct {
+	latitude  float64
+	longitude float64
+}
+
+// NewObserver creates an observer at the latitude [-90, 90] and longitude [-180, 180]
+// in degrees, with north and east positive.
+//
+// Returns ErrValueOutOfRange for coordinates outside these ranges.
+func NewObserver(latitude, longitude float64) (Observer, error) {
+	if !(latitude >= -90 && latitude <= 90) {
+		return Observer{}, errorf("NewObserver", latitude, ErrValueOutOfRange)
+	}
+	if !(longitude >= -180 && longitude <= 180) {
+		return Observer{}, errorf("NewObserver", longitude, ErrValueOutOfRange)
+	}
+	return Observer{latitude: latitude, longitude: longitude}, nil
+}
+
+// MustObserver creates a new observer, panicking on error.
+func MustObserver(latitude, longitude float64) Observer {
+	o, err := NewObserver(latitude, longitude)
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Latitude returns the latitude of the observer in degrees.
+func (o Observer) Latitude() float64 {
+	return o.latitude
+}
+
+// Longitude returns the longitude of the observer in degrees.
+func (o Observer) Longitude() float64 {
+	return o.longitude
+}
+
+// SolarNoon returns the time the sun is highest on the date, as a wall clock time in loc.
+//
+// Like Convert, it returns the daytime together with the number of days it is away from the
+// date in loc, which is non-zero only where the zone offset is far from the solar time.
+// Only the calendar date of date is used; a nil loc means the location of date.
+func (o Observer) SolarNoon(date time.Time, loc *time.Location) (Daytime, int) {
+	day := utcDate(date)
+	minutes := o.noon(day, julianCentury(day, 720-4*o.longitude))
+	return solarDaytime(date, loc, day, minutes)
+}
+
+// Sunrise returns the time the upper edge of the sun rises above the horizon on the date,
+// as a wall clock time in loc, with the days it is away from the date as in SolarNoon.
+//
+// Returns ErrPolarDay if the sun does not set that day and ErrPolarNight if it does not rise.
+func (o Observer) Sunrise(date time.Time, loc *time.Location) (Daytime, int, error) {
+	return o.event("Sunrise", date, loc, sunriseElevation, -1)
+}
+
+// Sunset returns the time the upper edge of the sun sets below the horizon on the date,
+// as a wall clock time in loc, with the days it is away from the date as in SolarNoon.
+//
+// Returns ErrPolarDay if the sun does not set that day and ErrPolarNight if it does not rise.
+func (o Observer) Sunset(date time.Time, loc *time.Location) (Daytime, int, error) {
+	return o.event("Sunset", date, loc, sunriseElevation, 1)
+}
+
+// Dawn returns the time the twilight begins in the morning of the date,
+// as a wall clock time in loc, with the days it is away from the date as in SolarNoon.
+//
+// Returns ErrPolarDay if the sun stays above the twilight elevation all day, e.g. astronomical
+// dawn during summer at mid-latitudes, ErrPolarNight if it stays below, and ErrValueOutOfRange
+// for an unknown twilight.
+func (o Observer) Dawn(date time.Time, loc *time.Location, twilight Twilight) (Daytime, int, error) {
+	elevation, ok := twilight.elevation()
+	if !ok {
+		return 0, 0, errorf("Dawn", twilight, ErrValueOutOfRange)
+	}
+	return o.event("Dawn", date, loc, elevation, -1)
+}
+
+// Dusk returns the time the twilight ends in the evening of the date,
+// as a wall clock time in loc, with the days it is away from the date as in SolarNoon.
+//
+// Returns the same errors as Dawn.
+func (o Observer) Dusk(date time.Time, loc *time.Location, twilight Twilight) (Daytime, int, error) {
+	elevation, ok := twilight.elevation()
+	if !ok {
+		return 0, 0, errorf("Dusk", twilight, ErrValueOutOfRange)
+	}
+	return o.event("Dusk", date, loc, elevation, 1)
+}
+
+// Daylight returns the range from sunrise to sunset on the date as wall clock times in loc.
+//
+// The range is ClosedOpen and wraps around midnight if sunset falls on the next day in loc.
+// Returns ErrPolarDay or ErrPolarNight like Sunrise.
+func (o Observer) Daylight(date time.Time, loc *time.Location) (Range, error) {
+	rise, _, err := o.event("Daylight", date, loc, sunriseElevation, -1)
+	if err != nil {
+		return Range{}, err
+	}
+	set, _, err := o.event("Daylight", date, loc, sunriseElevation, 1)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{start: rise, end: set, bounds: ClosedOpen}, nil
+}
+
+// --- Helper functions ---
+
+// event computes the time the sun passes the elevation on the date, rising for a negative
+// direction and setting for a positive one, refining the position of the sun at the event.
+func (o Observer) event(op string, date time.Time, loc *time.Location, elevation float64, direction float64) (Daytime, int, error) {
+	day := utcDate(date)
+	minutes := 720 - 4*o.longitude
+	for range 3 {
+		t := julianCentury(day, minutes)
+		angle, err := o.hourAngle(t, elevation)
+		if err != nil {
+			return 0, 0, errorf(op, elevation, err)
+		}
+		minutes = o.noon(day, t) + direction*4*angle
+	}
+	d, days := solarDaytime(date, loc, day, minutes)
+	return d, days, nil
+}
+
+// noon returns the solar noon in minutes after midnight UTC for the Julian century t.
+func (o Observer) noon(day time.Time, t float64) float64 {
+	// Refine with the equation of time at the noon itself.
+	minutes := 720 - 4*o.longitude - equationOfTime(t)
+	return 720 - 4*o.longitude - equationOfTime(julianCentury(day, minutes))
+}
+
+// hourAngle returns the hour angle in degrees at which the sun reaches the elevation.
+func (o Observer) hourAngle(t, elevation float64) (float64, error) {
+	lat := radians(o.latitude)
+	decl := radians(sunDeclination(t))
+	cosAngle := (math.Sin(radians(elevation)) - math.Sin(lat)*math.Sin(decl)) / (math.Cos(lat) * math.Cos(decl))
+	switch {
+	case cosAngle > 1:
+		return 0, ErrPolarNight
+	case cosAngle < -1:
+		return 0, ErrPolarDay
+	}
+	return degrees(math.Acos(cosAngle)), nil
+}
+
+// solarDaytime converts minutes after midnight UTC of day to a wall clock time in loc,
+// with the days it is away from the date.
+func solarDaytime(date time.Time, loc *time.Location, day time.Time, minutes float64) (Daytime, int) {
+	instant := day.Add(time.Duration(minutes * float64(time.Minute))).Round(time.Second)
+	return FromTimeOffset(referenceDate(date, loc), instant)
+}
+
+// utcDate returns midnight UTC of the calendar date of date.
+func utcDate(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// julianCentury returns the Julian centuries since J2000.0 at minutes after midnight UTC of day.
+func julianCentury(day time.Time, minutes float64) float64 {
+	// The Unix epoch is Julian day 2440587.5, and J2000.0 is Julian day 2451545.
+	julianDay := float64(day.Unix())/secondsInDay + 2440587.5 + minutes/(24*60)
+	return (julianDay - 2451545) / 36525
+}
+
+// sunDeclination returns the declination of the sun in degrees.
+func sunDeclination(t float64) float64 {
+	return degrees(math.Asin(math.Sin(radians(obliquityCorrection(t))) * math.Sin(radians(sunApparentLongitude(t)))))
+}
+
+// equationOfTime returns the difference between apparent and mean solar time in minutes.
+func equationOfTime(t float64) float64 {
+	epsilon := radians(obliquityCorrection(t))
+	l0 := radians(sunMeanLongitude(t))
+	e := earthOrbitEccentricity(t)
+	m := radians(sunMeanAnomaly(t))
+
+	y := math.Tan(epsilon / 2)
+	y *= y
+	eq := y*math.Sin(2*l0) -
+		2*e*math.Sin(m) +
+		4*e*y*math.Sin(m)*math.Cos(2*l0) -
+		0.5*y*y*math.Sin(4*l0) -
+		1.25*e*e*math.Sin(2*m)
+	return 4 * degrees(eq)
+}
+
+// sunMeanLongitude returns the geometric mean longitude of the sun in degrees.
+func sunMeanLongitude(t float64) float64 {
+	l0 := math.Mod(280.46646+t*(36000.76983+t*0.0003032), 360)
+	if l0 < 0 {
+		l0 += 360
+	}
+	return l0
+}
+
+// sunMeanAnomaly returns the geometric mean anomaly of the sun in degrees.
+func sunMeanAnomaly(t float64) float64 {
+	return 357.52911 + t*(35999.05029-0.0001537*t)
+}
+
+// earthOrbitEccentricity returns the eccentricity of the orbit of the Earth.
+func earthOrbitEccentricity(t float64) float64 {
+	return 0.016708634 - t*(0.000042037+0.0000001267*t)
+}
+
+// sunApparentLongitude returns the apparent longitude of the sun in degrees.
+func sunApparentLongitude(t float64) float64 {
+	m := radians(sunMeanAnomaly(t))
+	center := math.Sin(m)*(1.914602-t*(0.004817+0.000014*t)) +
+		math.Sin(2*m)*(0.019993-0.000101*t) +
+		math.Sin(3*m)*0.000289
+	omega := radians(125.04 - 1934.136*t)
+	return sunMeanLongitude(t) + center - 0.00569 - 0.00478*math.Sin(omega)
+}
+
+// obliquityCorrection returns the corrected obliquity of the ecliptic in degrees.
+func obliquityCorrection(t float64) float64 {
+	seconds := 21.448 - t*(46.8150+t*(0.00059-t*0.001813))
+	mean := 23 + (26+seconds/60)/60
+	return mean + 0.00256*math.Cos(radians(125.04-1934.136*t))
+}
+
+// radians converts degrees to radians.
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// degrees converts radians to degrees.
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
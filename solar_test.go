@@ -0,0 +1,151 @@
+package daytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// withinMinute reports whether two daytimes are at most a minute apart.
+func withinMinute(a, b Daytime) bool {
+	diff := int(a) - int(b)
+	return diff >= -60 && diff <= 60
+}
+
+func TestNewObserver(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lon float64
+		err      error
+	}{
+		{"Valid", 52.52, 13.405, nil},
+		{"Poles and date line", -90, 180, nil},
+		{"Latitude too large", 90.5, 0, ErrValueOutOfRange},
+		{"Longitude too small", 0, -180.5, ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := NewObserver(tt.lat, tt.lon)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("NewObserver() got error %v, want %v", err, tt.err)
+			}
+			if err == nil && (o.Latitude() != tt.lat || o.Longitude() != tt.lon) {
+				t.Errorf("NewObserver() got (%v, %v), want (%v, %v)", o.Latitude(), o.Longitude(), tt.lat, tt.lon)
+			}
+		})
+	}
+}
+
+func TestObserver_SunriseSunset(t *testing.T) {
+	// Reference times from the NOAA solar calculator, rounded to the minute.
+	tests := []struct {
+		name         string
+		lat, lon     float64
+		zone         string
+		date         [3]int
+		noon         Daytime
+		sunrise      Daytime
+		sunset       Daytime
+		civilDawn    Daytime
+		civilDusk    Daytime
+		nauticalDawn Daytime
+	}{
+		{"Berlin summer solstice", 52.52, 13.405, "Europe/Berlin", [3]int{2025, 6, 21},
+			Must(13, 8, 0), Must(4, 43, 0), Must(21, 33, 0), Must(3, 53, 0), Must(22, 23, 0), Must(2, 29, 0)},
+		{"New York winter solstice", 40.7128, -74.006, "America/New_York", [3]int{2025, 12, 21},
+			Must(11, 54, 0), Must(7, 17, 0), Must(16, 32, 0), Must(6, 46, 0), Must(17, 3, 0), Must(6, 11, 0)},
+		{"Sydney summer", -33.8688, 151.2093, "Australia/Sydney", [3]int{2025, 12, 21},
+			Must(12, 53, 0), Must(5, 41, 0), Must(20, 5, 0), Must(5, 12, 0), Must(20, 35, 0), Must(4, 36, 0)},
+		{"London equinox", 51.5074, -0.1278, "Europe/London", [3]int{2025, 3, 20},
+			Must(12, 8, 0), Must(6, 3, 0), Must(18, 14, 0), Must(5, 29, 0), Must(18, 47, 0), Must(4, 50, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := mustLoadLocation(t, tt.zone)
+			o := MustObserver(tt.lat, tt.lon)
+			date := time.Date(tt.date[0], time.Month(tt.date[1]), tt.date[2], 12, 0, 0, 0, loc)
+
+			check := func(name string, got Daytime, days int, err error, want Daytime) {
+				t.Helper()
+				if err != nil || days != 0 || !withinMinute(got, want) {
+					t.Errorf("%s() got (%s, %d, %v), want about %s", name, got, days, err, want)
+				}
+			}
+
+			noon, days := o.SolarNoon(date, loc)
+			check("SolarNoon", noon, days, nil, tt.noon)
+			d, days, err := o.Sunrise(date, loc)
+			check("Sunrise", d, days, err, tt.sunrise)
+			d, days, err = o.Sunset(date, loc)
+			check("Sunset", d, days, err, tt.sunset)
+			d, days, err = o.Dawn(date, loc, Civil)
+			check("Dawn(Civil)", d, days, err, tt.civilDawn)
+			d, days, err = o.Dusk(date, loc, Civil)
+			check("Dusk(Civil)", d, days, err, tt.civilDusk)
+			d, days, err = o.Dawn(date, loc, Nautical)
+			check("Dawn(Nautical)", d, days, err, tt.nauticalDawn)
+
+			r, err := o.Daylight(date, loc)
+			if err != nil || !withinMinute(r.Start(), tt.sunrise) || !withinMinute(r.End(), tt.sunset) || r.Bounds() != ClosedOpen {
+				t.Errorf("Daylight() got (%s, %v), want about %s-%s", r, err, tt.sunrise, tt.sunset)
+			}
+		})
+	}
+}
+
+func TestObserver_Polar(t *testing.T) {
+	oslo := mustLoadLocation(t, "Europe/Oslo")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	tromso := MustObserver(69.6492, 18.9553)
+	summer := time.Date(2025, time.June, 21, 0, 0, 0, 0, oslo)
+	winter := time.Date(2025, time.December, 21, 0, 0, 0, 0, oslo)
+
+	tests := []struct {
+		name string
+		call func() (Daytime, int, error)
+		err  error
+	}{
+		{"Midnight sun has no sunrise", func() (Daytime, int, error) { return tromso.Sunrise(summer, oslo) }, ErrPolarDay},
+		{"Midnight sun has no sunset", func() (Daytime, int, error) { return tromso.Sunset(summer, oslo) }, ErrPolarDay},
+		{"Midnight sun has no civil dusk", func() (Daytime, int, error) { return tromso.Dusk(summer, oslo, Civil) }, ErrPolarDay},
+		{"Polar night has no sunrise", func() (Daytime, int, error) { return tromso.Sunrise(winter, oslo) }, ErrPolarNight},
+		{"Polar night has no sunset", func() (Daytime, int, error) { return tromso.Sunset(winter, oslo) }, ErrPolarNight},
+		{"Polar night still has civil dawn", func() (Daytime, int, error) { return tromso.Dawn(winter, oslo, Civil) }, nil},
+		{"Berlin summer never gets astronomically dark", func() (Daytime, int, error) {
+			return MustObserver(52.52, 13.405).Dawn(time.Date(2025, time.June, 21, 0, 0, 0, 0, berlin), berlin, Astronomical)
+		}, ErrPolarDay},
+		{"Unknown twilight", func() (Daytime, int, error) { return tromso.Dawn(winter, oslo, Twilight(9)) }, ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := tt.call(); !errors.Is(err, tt.err) {
+				t.Errorf("got error %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if _, err := tromso.Daylight(summer, oslo); !errors.Is(err, ErrPolarDay) {
+		t.Errorf("Daylight() in summer got error %v, want %v", err, ErrPolarDay)
+	}
+	if _, err := tromso.Daylight(winter, oslo); !errors.Is(err, ErrPolarNight) {
+		t.Errorf("Daylight() in winter got error %v, want %v", err, ErrPolarNight)
+	}
+}
+
+func TestObserver_DayOffset(t *testing.T) {
+	// Near the date line, UTC midnight is close to local solar noon,
+	// so sunrise falls on the previous UTC date.
+	o := MustObserver(0, 170)
+	date := time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)
+
+	d, days, err := o.Sunrise(date, time.UTC)
+	if err != nil || days != -1 || !withinMinute(d, Must(18, 44, 0)) {
+		t.Errorf("Sunrise() got (%s, %d, %v), want about 18:44:00 on the previous day", d, days, err)
+	}
+	if r, err := o.Daylight(date, time.UTC); err != nil || !r.Wraps() {
+		t.Errorf("Daylight() got (%s, %v), want a range spanning midnight", r, err)
+	}
+}
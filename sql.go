@@ -0,0 +1,93 @@
+package daytime
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Scan implements the sql.Scanner interface.
+//
+// Supported source types:
+//
+//   - time.Time: the time-of-day portion, as returned for PostgreSQL and MySQL TIME columns
+//   - string, []byte: any format supported by Parse; fractional seconds after
+//     HH:MM:SS are truncated like FromTime does (e.g., "09:00:00.250")
+//   - int64: integer seconds since midnight
+//
+// Returns ErrInvalidFormat for NULL or unsupported sources; use NullDaytime for nullable columns.
+func (d *Daytime) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		*d = FromTime(v)
+		return nil
+	case string:
+		return d.scanString(v)
+	case []byte:
+		return d.scanString(string(v))
+	case int64:
+		if v < 0 || v > secondsInDay {
+			return errorf("Scan", v, ErrValueOutOfRange)
+		}
+		*d = Daytime(v)
+		return nil
+	default:
+		return errorf("Scan", src, ErrInvalidFormat)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+//
+// The daytime is stored in HH:MM:SS format, with EndOfDay stored as "24:00:00".
+// Returns ErrValueOutOfRange for invalid daytimes.
+func (d Daytime) Value() (driver.Value, error) {
+	if !d.Valid() {
+		return nil, errorf("Value", uint32(d), ErrValueOutOfRange)
+	}
+	return d.String(), nil
+}
+
+// NullDaytime represents a daytime that may be null.
+//
+// NullDaytime implements the sql.Scanner and driver.Valuer interfaces
+// so it can be used as a scan destination and a query argument, similar to sql.NullTime.
+type NullDaytime struct {
+	Daytime Daytime
+	Valid   bool // Valid is true if Daytime is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullDaytime) Scan(src any) error {
+	if src == nil {
+		n.Daytime, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Daytime.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullDaytime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Daytime.Value()
+}
+
+// scanString parses a textual column value, dropping fractional seconds.
+func (d *Daytime) scanString(s string) error {
+	if whole, frac, ok := strings.Cut(s, "."); ok && len(whole) == 8 && frac != "" && strings.Trim(frac, "0123456789") == "" {
+		s = whole
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return errorf("Scan", s, errors.Unwrap(err))
+	}
+	*d = parsed
+	return nil
+}
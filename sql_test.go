@@ -0,0 +1,199 @@
+package daytime
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// fakeDriver is an in-memory database/sql driver storing a single column of values.
+// Every Exec appends its first argument, and every Query returns all stored values.
+type fakeDriver struct {
+	values []driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+
+type fakeConn struct{ driver *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{conn: c}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ conn *fakeConn }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.values = append(s.conn.driver.values, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{values: s.conn.driver.values}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+	pos    int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"opens"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.pos]
+	r.pos++
+	return nil
+}
+
+// openFakeDB returns a database backed by a fresh fakeDriver holding the given values.
+func openFakeDB(t *testing.T, values ...driver.Value) (*sql.DB, *fakeDriver) {
+	t.Helper()
+
+	drv := &fakeDriver{values: values}
+	db := sql.OpenDB(fakeConnector{drv})
+	t.Cleanup(func() { db.Close() })
+	return db, drv
+}
+
+type fakeConnector struct{ driver *fakeDriver }
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return c.driver.Open("") }
+func (c fakeConnector) Driver() driver.Driver                        { return c.driver }
+
+func TestDaytime_Scan(t *testing.T) {
+	tests := []struct {
+		name string
+		src  any
+		want Daytime
+		err  error
+	}{
+		{"time.Time (PostgreSQL time)", time.Date(0, time.January, 1, 12, 30, 45, 0, time.UTC), D123045, nil},
+		{"time.Time drops nanoseconds", time.Date(0, time.January, 1, 12, 30, 45, 999, time.UTC), D123045, nil},
+		{"string (SQLite text)", "12:30:45", D123045, nil},
+		{"[]byte (MySQL TIME)", []byte("24:00:00"), D240000, nil},
+		{"string with fractional seconds", "12:30:45.123456", D123045, nil},
+		{"int64 seconds", int64(3600), D010000, nil},
+		{"Error: int64 out of range", int64(86401), 0, ErrValueOutOfRange},
+		{"Error: negative int64", int64(-1), 0, ErrValueOutOfRange},
+		{"Error: invalid string", "12:30", 0, ErrInvalidFormat},
+		{"Error: invalid fraction", "12:30:45.x", 0, ErrInvalidFormat},
+		{"Error: NULL", nil, 0, ErrInvalidFormat},
+		{"Error: unsupported type", 1.5, 0, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Daytime
+			err := got.Scan(tt.src)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Scan(%v) got error %v, want %v", tt.src, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan(%v) got %s, want %s", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaytime_Value(t *testing.T) {
+	got, err := D240000.Value()
+	if err != nil || got != "24:00:00" {
+		t.Errorf("EndOfDay.Value() got (%v, %v), want (24:00:00, nil)", got, err)
+	}
+	if _, err := DInvalid.Value(); !errors.Is(err, ErrValueOutOfRange) {
+		t.Errorf("Value() for invalid daytime got error %v, want %v", err, ErrValueOutOfRange)
+	}
+}
+
+func TestNullDaytime(t *testing.T) {
+	var n NullDaytime
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) got (%+v, %v), want invalid without error", n, err)
+	}
+	if v, err := n.Value(); v != nil || err != nil {
+		t.Errorf("Value() for NULL got (%v, %v), want (nil, nil)", v, err)
+	}
+
+	if err := n.Scan("09:00:00"); err != nil || !n.Valid || n.Daytime != Must(9, 0, 0) {
+		t.Errorf("Scan(09:00:00) got (%+v, %v), want valid 09:00:00", n, err)
+	}
+	if v, err := n.Value(); v != "09:00:00" || err != nil {
+		t.Errorf("Value() got (%v, %v), want (09:00:00, nil)", v, err)
+	}
+
+	if err := n.Scan("bogus"); !errors.Is(err, ErrInvalidFormat) || n.Valid {
+		t.Errorf("Scan(bogus) got (%+v, %v), want invalid with %v", n, err, ErrInvalidFormat)
+	}
+}
+
+func TestDaytime_SQLRoundTrip(t *testing.T) {
+	db, drv := openFakeDB(t)
+
+	for _, arg := range []any{D123045, NullDaytime{}, NullDaytime{Daytime: D240000, Valid: true}} {
+		if _, err := db.Exec("INSERT", arg); err != nil {
+			t.Fatalf("Exec(%v) got unexpected error: %v", arg, err)
+		}
+	}
+	wantStored := []driver.Value{"12:30:45", nil, "24:00:00"}
+	for i, v := range drv.values {
+		if v != wantStored[i] {
+			t.Errorf("stored value %d got %v, want %v", i, v, wantStored[i])
+		}
+	}
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("Query() got unexpected error: %v", err)
+	}
+	defer rows.Close()
+
+	var got []NullDaytime
+	for rows.Next() {
+		var n NullDaytime
+		if err := rows.Scan(&n); err != nil {
+			t.Fatalf("rows.Scan() got unexpected error: %v", err)
+		}
+		got = append(got, n)
+	}
+	want := []NullDaytime{{Daytime: D123045, Valid: true}, {}, {Daytime: D240000, Valid: true}}
+	if len(got) != len(want) {
+		t.Fatalf("scanned %d rows, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("scanned value %d got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	t.Run("Driver time values", func(t *testing.T) {
+		db, _ := openFakeDB(t, time.Date(0, time.January, 1, 6, 0, 0, 0, time.UTC), int64(3600))
+
+		rows, err := db.Query("SELECT")
+		if err != nil {
+			t.Fatalf("Query() got unexpected error: %v", err)
+		}
+		defer rows.Close()
+
+		var got []Daytime
+		for rows.Next() {
+			var d Daytime
+			if err := rows.Scan(&d); err != nil {
+				t.Fatalf("rows.Scan() got unexpected error: %v", err)
+			}
+			got = append(got, d)
+		}
+		if len(got) != 2 || got[0] != D060000 || got[1] != D010000 {
+			t.Errorf("scanned daytimes got %v, want [06:00:00 01:00:00]", got)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package daytime
+
+import (
+	"iter"
+	"time"
+)
+
+// Steps returns the daytimes from start up to, but not including, end at the given step.
+//
+// If start is after end the sequence wraps around midnight, e.g. from 22:00:00 to 02:00:00
+// every hour yields 22:00:00, 23:00:00, 00:00:00 and 01:00:00. It is equivalent to
+// Steps of the ClosedOpen range from start to end; use a range with a closed end
+// to include end, such as EndOfDay.
+//
+// The step is truncated to whole seconds. The sequence is empty if the step is
+// shorter than a second or start or end is invalid.
+func Steps(start, end Daytime, step time.Duration) iter.Seq[Daytime] {
+	return Range{start: start, end: end, bounds: ClosedOpen}.Steps(step)
+}
+
+// Steps returns the daytimes of the range at the given step, starting from its start.
+//
+// An excluded start is skipped, and the end is included if the bounds include it and it
+// falls on the step grid. A range spanning midnight yields 00:00:00 when passing midnight;
+// EndOfDay is only yielded as an endpoint of the range.
+//
+// The step is truncated to whole seconds. The sequence is empty if the step is
+// shorter than a second or the range is empty or invalid.
+func (r Range) Steps(step time.Duration) iter.Seq[Daytime] {
+	return func(yield func(Daytime) bool) {
+		seconds := int(step / time.Second)
+		if seconds <= 0 || !r.start.Valid() || !r.end.Valid() || r.IsEmpty() {
+			return
+		}
+
+		// Walk seconds since the start of the start day; the end of a wrapped range is on the next day.
+		start, end := int(r.start), int(r.end)
+		if r.Wraps() {
+			end += secondsInDay
+		}
+
+		value := start
+		if !r.IncludesStart() {
+			value += seconds
+		}
+		for ; value < end || value == end && r.IncludesEnd(); value += seconds {
+			d := Daytime(value % secondsInDay)
+			switch value {
+			case start:
+				d = r.start
+			case end:
+				d = r.end
+			}
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package daytime
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSteps(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end Daytime
+		step       time.Duration
+		want       []Daytime
+	}{
+		{"Hourly", D060000, Must(9, 0, 0), time.Hour, []Daytime{D060000, Must(7, 0, 0), Must(8, 0, 0)}},
+		{"End off the grid", D060000, Must(7, 0, 0), 25 * time.Minute, []Daytime{D060000, Must(6, 25, 0), Must(6, 50, 0)}},
+		{"Wraparound", Must(22, 0, 0), Must(2, 0, 0), time.Hour, []Daytime{Must(22, 0, 0), D230000, D000000, D010000}},
+		{"Up to EndOfDay excludes it", D230000, D240000, 30 * time.Minute, []Daytime{D230000, Must(23, 30, 0)}},
+		{"Sub-second part is truncated", D000000, Must(0, 0, 3), 1500 * time.Millisecond, []Daytime{D000000, Must(0, 0, 1), Must(0, 0, 2)}},
+		{"Empty when start equals end", D120000, D120000, time.Hour, nil},
+		{"Empty for zero step", D060000, D120000, 0, nil},
+		{"Empty for sub-second step", D060000, D120000, time.Millisecond, nil},
+		{"Empty for negative step", D060000, D120000, -time.Hour, nil},
+		{"Empty for invalid start", DInvalid, D120000, time.Hour, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(Steps(tt.start, tt.end, tt.step))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Steps() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSteps_SlotGrid(t *testing.T) {
+	slots := slices.Collect(Steps(Must(8, 0, 0), D180000, 15*time.Minute))
+	if len(slots) != 40 {
+		t.Fatalf("Steps() got %d slots, want 40", len(slots))
+	}
+	if slots[0] != Must(8, 0, 0) || slots[len(slots)-1] != Must(17, 45, 0) {
+		t.Errorf("Steps() got slots from %s to %s, want 08:00:00 to 17:45:00", slots[0], slots[len(slots)-1])
+	}
+}
+
+func TestRange_Steps(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		step time.Duration
+		want []Daytime
+	}{
+		{"Closed includes end", MustRange(D060000, Must(8, 0, 0), Closed), time.Hour, []Daytime{D060000, Must(7, 0, 0), Must(8, 0, 0)}},
+		{"Open excludes both", MustRange(D060000, Must(9, 0, 0), Open), time.Hour, []Daytime{Must(7, 0, 0), Must(8, 0, 0)}},
+		{"Open-closed", MustRange(D060000, Must(8, 0, 0), OpenClosed), time.Hour, []Daytime{Must(7, 0, 0), Must(8, 0, 0)}},
+		{"Closed includes EndOfDay", MustRange(Must(22, 0, 0), D240000, Closed), time.Hour, []Daytime{Must(22, 0, 0), D230000, D240000}},
+		{"Closed end off the grid", MustRange(D060000, Must(7, 30, 0), Closed), time.Hour, []Daytime{D060000, Must(7, 0, 0)}},
+		{"Wraparound closed", MustRange(D230000, D010000, Closed), 30 * time.Minute, []Daytime{D230000, Must(23, 30, 0), D000000, Must(0, 30, 0), D010000}},
+		{"Wraparound ending at midnight", MustRange(D230000, D000000, Closed), 30 * time.Minute, []Daytime{D230000, Must(23, 30, 0), D000000}},
+		{"Starting at EndOfDay", MustRange(D240000, D010000, Closed), 30 * time.Minute, []Daytime{D240000, Must(0, 30, 0), D010000}},
+		{"Closed single point", MustRange(D120000, D120000, Closed), time.Hour, []Daytime{D120000}},
+		{"Full day", MustRange(D000000, D240000, Closed), 6 * time.Hour, []Daytime{D000000, D060000, D120000, D180000, D240000}},
+		{"Empty range", MustRange(D120000, D120000, Open), time.Hour, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(tt.r.Steps(tt.step))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Steps() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_Steps_Break(t *testing.T) {
+	var got []Daytime
+	for d := range MustRange(D000000, D240000, Closed).Steps(time.Hour) {
+		if d == D060000 {
+			break
+		}
+		got = append(got, d)
+	}
+	if len(got) != 6 {
+		t.Errorf("Steps() yielded %v before break, want 6 daytimes", got)
+	}
+}
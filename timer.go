@@ -0,0 +1,244 @@
+package daytime
+
+import (
+	"sync"
+	"time"
+)
+
+// DailyTimer fires at a daytime in a location, either every day or once.
+//
+// Each firing is scheduled as an absolute instant, so the timer keeps the wall clock time
+// across daylight saving transitions. Stop and Reset behave like those of time.Timer.
+type DailyTimer struct {
+	// C delivers the current time at each firing. It is nil for timers calling a function.
+	C <-chan time.Time
+
+	c      chan time.Time
+	f      func(time.Time)
+	once   bool
+	loc    *time.Location
+	clock  Clock
+	policy DSTPolicy
+
+	mu     sync.Mutex
+	d      Daytime
+	timer  Timer
+	next   time.Time
+	active bool
+	// gen identifies the current schedule, so that stale callbacks are ignored after Stop or Reset.
+	gen uint64
+}
+
+// TimerOption configures a DailyTimer.
+type TimerOption func(*DailyTimer)
+
+// WithClock sets the clock used to read the time and wait, SystemClock by default.
+func WithClock(clock Clock) TimerOption {
+	return func(t *DailyTimer) {
+		t.clock = clock
+	}
+}
+
+// WithDSTPolicy sets how firings at nonexistent or ambiguous local times are resolved,
+// ShiftForward by default. With Skip the timer does not fire on days where the daytime
+// falls into a daylight saving gap.
+func WithDSTPolicy(policy DSTPolicy) TimerOption {
+	return func(t *DailyTimer) {
+		t.policy = policy
+	}
+}
+
+// NewDailyTimer creates a timer that sends the current time on its channel every day
+// when the wall clock in loc shows the daytime. A nil loc means time.Local.
+//
+// Like time.Ticker, the channel has a buffer of one and firings are dropped for slow receivers.
+// Panics if the daytime is invalid.
+func NewDailyTimer(d Daytime, loc *time.Location, opts ...TimerOption) *DailyTimer {
+	c := make(chan time.Time, 1)
+	t := newDailyTimer("NewDailyTimer", d, loc, opts)
+	t.C, t.c = c, c
+	t.start()
+	return t
+}
+
+// NewDailyFunc creates a timer that calls f in its own goroutine every day
+// when the wall clock in loc shows the daytime, passing the current time.
+// A nil loc means time.Local.
+//
+// Panics if the daytime is invalid.
+func NewDailyFunc(d Daytime, loc *time.Location, f func(time.Time), opts ...TimerOption) *DailyTimer {
+	t := newDailyTimer("NewDailyFunc", d, loc, opts)
+	t.f = f
+	t.start()
+	return t
+}
+
+// AfterDaytime waits until the wall clock in loc next shows the daytime
+// and then sends the current time on the returned channel, like time.After.
+// A nil loc means time.Local.
+//
+// Panics if the daytime is invalid.
+func AfterDaytime(d Daytime, loc *time.Location, opts ...TimerOption) <-chan time.Time {
+	c := make(chan time.Time, 1)
+	t := newDailyTimer("AfterDaytime", d, loc, opts)
+	t.C, t.c, t.once = c, c, true
+	t.start()
+	return c
+}
+
+// AfterDaytimeFunc waits until the wall clock in loc next shows the daytime
+// and then calls f in its own goroutine, like time.AfterFunc.
+// A nil loc means time.Local.
+//
+// The returned timer can be stopped, or reset to fire once more.
+// Panics if the daytime is invalid.
+func AfterDaytimeFunc(d Daytime, loc *time.Location, f func(time.Time), opts ...TimerOption) *DailyTimer {
+	t := newDailyTimer("AfterDaytimeFunc", d, loc, opts)
+	t.f, t.once = f, true
+	t.start()
+	return t
+}
+
+// Daytime returns the daytime at which the timer fires.
+func (t *DailyTimer) Daytime() Daytime {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.d
+}
+
+// Next returns the instant of the next firing.
+// Returns false if the timer is stopped or has already fired once.
+func (t *DailyTimer) Next() (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.next, t.active
+}
+
+// Stop prevents the timer from firing.
+//
+// Returns true if the call stops the timer, false if it has already been stopped
+// or a one-shot timer has already fired. Stop does not drain the channel.
+func (t *DailyTimer) Stop() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	wasActive := t.active
+	t.cancel()
+	return wasActive
+}
+
+// Reset changes the daytime of the timer and schedules its next firing from now,
+// restarting a stopped or expired timer.
+//
+// Returns true if the timer had been active. Panics if the daytime is invalid.
+func (t *DailyTimer) Reset(d Daytime) bool {
+	if !d.Valid() {
+		panic(errorf("Reset", uint32(d), ErrValueOutOfRange))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	wasActive := t.active
+	t.cancel()
+	t.d = d
+	t.schedule(t.clock.Now())
+	return wasActive
+}
+
+// --- Helper functions ---
+
+// newDailyTimer creates an unscheduled timer.
+func newDailyTimer(op string, d Daytime, loc *time.Location, opts []TimerOption) *DailyTimer {
+	if !d.Valid() {
+		panic(errorf(op, uint32(d), ErrValueOutOfRange))
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+
+	t := &DailyTimer{d: d, loc: loc, clock: SystemClock}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// start schedules the first firing.
+func (t *DailyTimer) start() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.schedule(t.clock.Now())
+}
+
+// cancel stops the pending firing. The caller must hold t.mu.
+func (t *DailyTimer) cancel() {
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	t.active = false
+	t.gen++
+}
+
+// schedule arranges the next firing after the given time. The caller must hold t.mu.
+func (t *DailyTimer) schedule(after time.Time) {
+	next, ok := nextFiring(t.d, t.loc, t.policy, after)
+	if !ok {
+		return
+	}
+
+	t.next, t.active = next, true
+	gen := t.gen
+	t.timer = t.clock.AfterFunc(next.Sub(t.clock.Now()), func() { t.fire(gen) })
+}
+
+// fire delivers a firing of the schedule identified by gen and schedules the following one.
+func (t *DailyTimer) fire(gen uint64) {
+	t.mu.Lock()
+	if gen != t.gen || !t.active {
+		t.mu.Unlock()
+		return
+	}
+
+	now := t.clock.Now()
+	if t.once {
+		t.active = false
+		t.timer = nil
+	} else {
+		// Schedule from the planned instant, so that an early wakeup cannot repeat the firing.
+		t.schedule(later(now, t.next))
+	}
+	t.mu.Unlock()
+
+	if t.c != nil {
+		select {
+		case t.c <- now:
+		default:
+		}
+	}
+	if t.f != nil {
+		t.f(now)
+	}
+}
+
+// nextFiring returns the first instant after the given time at which the daytime
+// is resolved in loc with the policy, at most once per calendar date.
+func nextFiring(d Daytime, loc *time.Location, policy DSTPolicy, after time.Time) (time.Time, bool) {
+	year, month, day := after.In(loc).Date()
+	for i := range occurrenceDays {
+		date := time.Date(year, month, day+i, 0, 0, 0, 0, time.UTC)
+		if next, ok := d.TimeIn(date, loc, policy); ok && next.After(after) {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// later returns the later of two times.
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
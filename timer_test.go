@@ -0,0 +1,264 @@
+package daytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// receive returns the value waiting on c, failing if there is none.
+func receive(t *testing.T, c <-chan time.Time) time.Time {
+	t.Helper()
+	select {
+	case v := <-c:
+		return v
+	default:
+		t.Fatal("expected a firing, got none")
+		return time.Time{}
+	}
+}
+
+// expectNone fails if a value is waiting on c.
+func expectNone(t *testing.T, c <-chan time.Time) {
+	t.Helper()
+	select {
+	case v := <-c:
+		t.Fatalf("expected no firing, got %v", v)
+	default:
+	}
+}
+
+func TestDailyTimer_FiresEveryDay(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	clock := NewFakeClock(time.Date(2025, time.June, 1, 8, 0, 0, 0, berlin))
+
+	timer := NewDailyTimer(D120000, berlin, WithClock(clock))
+	defer timer.Stop()
+
+	clock.Advance(4*time.Hour - time.Second)
+	expectNone(t, timer.C)
+
+	for day := 1; day <= 3; day++ {
+		clock.Advance(time.Second)
+		want := time.Date(2025, time.June, day, 12, 0, 0, 0, berlin)
+		if got := receive(t, timer.C); !got.Equal(want) {
+			t.Fatalf("day %d: got firing at %v, want %v", day, got, want)
+		}
+		clock.Advance(24*time.Hour - time.Second)
+		expectNone(t, timer.C)
+	}
+}
+
+func TestDailyTimer_DST(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name   string
+		d      Daytime
+		start  time.Time
+		policy DSTPolicy
+		want   []time.Time
+	}{
+		{
+			"Keeps wall clock across spring forward", Must(9, 0, 0),
+			time.Date(2025, time.March, 29, 12, 0, 0, 0, berlin), ShiftForward,
+			[]time.Time{
+				time.Date(2025, time.March, 30, 9, 0, 0, 0, berlin),
+				time.Date(2025, time.March, 31, 9, 0, 0, 0, berlin),
+			},
+		},
+		{
+			"Keeps wall clock across fall back", Must(9, 0, 0),
+			time.Date(2025, time.October, 25, 12, 0, 0, 0, berlin), ShiftForward,
+			[]time.Time{
+				time.Date(2025, time.October, 26, 9, 0, 0, 0, berlin),
+				time.Date(2025, time.October, 27, 9, 0, 0, 0, berlin),
+			},
+		},
+		{
+			"Gap shifts forward", Must(2, 30, 0),
+			time.Date(2025, time.March, 29, 12, 0, 0, 0, berlin), ShiftForward,
+			[]time.Time{
+				time.Date(2025, time.March, 30, 3, 30, 0, 0, berlin),
+				time.Date(2025, time.March, 31, 2, 30, 0, 0, berlin),
+			},
+		},
+		{
+			"Gap skipped", Must(2, 30, 0),
+			time.Date(2025, time.March, 29, 12, 0, 0, 0, berlin), Skip,
+			[]time.Time{
+				time.Date(2025, time.March, 31, 2, 30, 0, 0, berlin),
+				time.Date(2025, time.April, 1, 2, 30, 0, 0, berlin),
+			},
+		},
+		{
+			"Overlap fires once at earliest", Must(2, 30, 0),
+			time.Date(2025, time.October, 25, 12, 0, 0, 0, berlin), Earliest,
+			[]time.Time{
+				time.Date(2025, time.October, 26, 0, 30, 0, 0, time.UTC),
+				time.Date(2025, time.October, 27, 2, 30, 0, 0, berlin),
+			},
+		},
+		{
+			"Overlap fires once at latest", Must(2, 30, 0),
+			time.Date(2025, time.October, 25, 12, 0, 0, 0, berlin), Latest,
+			[]time.Time{
+				time.Date(2025, time.October, 26, 1, 30, 0, 0, time.UTC),
+				time.Date(2025, time.October, 27, 2, 30, 0, 0, berlin),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := NewFakeClock(tt.start)
+			timer := NewDailyTimer(tt.d, berlin, WithClock(clock), WithDSTPolicy(tt.policy))
+			defer timer.Stop()
+
+			for _, want := range tt.want {
+				next, ok := timer.Next()
+				if !ok || !next.Equal(want) {
+					t.Fatalf("Next() got (%v, %v), want %v", next, ok, want)
+				}
+				clock.Advance(next.Sub(clock.Now()))
+				if got := receive(t, timer.C); !got.Equal(want) {
+					t.Fatalf("got firing at %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDailyTimer_StopReset(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	clock := NewFakeClock(time.Date(2025, time.June, 1, 8, 0, 0, 0, berlin))
+	timer := NewDailyTimer(D120000, berlin, WithClock(clock))
+
+	if !timer.Stop() {
+		t.Error("Stop() on active timer got false, want true")
+	}
+	if timer.Stop() {
+		t.Error("Stop() on stopped timer got true, want false")
+	}
+	if _, ok := timer.Next(); ok {
+		t.Error("Next() on stopped timer got true, want false")
+	}
+	clock.Advance(48 * time.Hour)
+	expectNone(t, timer.C)
+
+	// Now 2025-06-03 08:00.
+	if timer.Reset(D060000) {
+		t.Error("Reset() on stopped timer got true, want false")
+	}
+	if timer.Daytime() != D060000 {
+		t.Errorf("Daytime() got %s, want %s", timer.Daytime(), D060000)
+	}
+	clock.Advance(22 * time.Hour)
+	want := time.Date(2025, time.June, 4, 6, 0, 0, 0, berlin)
+	if got := receive(t, timer.C); !got.Equal(want) {
+		t.Fatalf("got firing at %v, want %v", got, want)
+	}
+
+	if !timer.Reset(D180000) {
+		t.Error("Reset() on active timer got false, want true")
+	}
+	clock.Advance(12 * time.Hour)
+	want = time.Date(2025, time.June, 4, 18, 0, 0, 0, berlin)
+	if got := receive(t, timer.C); !got.Equal(want) {
+		t.Fatalf("got firing at %v, want %v", got, want)
+	}
+	expectNone(t, timer.C)
+}
+
+func TestDailyFunc(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	clock := NewFakeClock(time.Date(2025, time.June, 1, 23, 0, 0, 0, berlin))
+
+	var got []time.Time
+	timer := NewDailyFunc(D240000, berlin, func(now time.Time) { got = append(got, now) }, WithClock(clock))
+	defer timer.Stop()
+
+	clock.Advance(49 * time.Hour)
+	want := []time.Time{
+		time.Date(2025, time.June, 2, 0, 0, 0, 0, berlin),
+		time.Date(2025, time.June, 3, 0, 0, 0, 0, berlin),
+		time.Date(2025, time.June, 4, 0, 0, 0, 0, berlin),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got firings %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("firing %d got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestAfterDaytime(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	clock := NewFakeClock(time.Date(2025, time.June, 1, 13, 0, 0, 0, berlin))
+
+	c := AfterDaytime(D120000, berlin, WithClock(clock))
+	clock.Advance(23 * time.Hour)
+	want := time.Date(2025, time.June, 2, 12, 0, 0, 0, berlin)
+	if got := receive(t, c); !got.Equal(want) {
+		t.Fatalf("got firing at %v, want %v", got, want)
+	}
+	clock.Advance(48 * time.Hour)
+	expectNone(t, c)
+}
+
+func TestAfterDaytimeFunc(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	clock := NewFakeClock(time.Date(2025, time.June, 1, 8, 0, 0, 0, berlin))
+
+	calls := 0
+	timer := AfterDaytimeFunc(D120000, berlin, func(time.Time) { calls++ }, WithClock(clock))
+	clock.Advance(72 * time.Hour)
+	if calls != 1 {
+		t.Fatalf("got %d calls, want 1", calls)
+	}
+	if timer.Stop() {
+		t.Error("Stop() after firing got true, want false")
+	}
+
+	if timer.Reset(D180000) {
+		t.Error("Reset() after firing got true, want false")
+	}
+	if !timer.Stop() {
+		t.Error("Stop() after Reset got false, want true")
+	}
+	clock.Advance(72 * time.Hour)
+	if calls != 1 {
+		t.Errorf("got %d calls after Stop, want 1", calls)
+	}
+}
+
+func TestNewDailyTimer_Invalid(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrValueOutOfRange) {
+			t.Errorf("NewDailyTimer() panicked with %v, want %v", err, ErrValueOutOfRange)
+		}
+	}()
+	NewDailyTimer(DInvalid, time.UTC)
+}
+
+func TestDailyTimer_SystemClock(t *testing.T) {
+	now := time.Now()
+	d := FromTime(now.Add(2 * time.Second))
+	if d == StartOfDay {
+		t.Skip("too close to midnight")
+	}
+
+	timer := NewDailyTimer(d, now.Location())
+	defer timer.Stop()
+	select {
+	case got := <-timer.C:
+		if FromTime(got) != d {
+			t.Errorf("got firing at %v, want %s", got, d)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer did not fire")
+	}
+}
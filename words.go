@@ -0,0 +1,200 @@
+package daytime
+
+import (
+	"strings"
+	"time"
+)
+
+// WordStyle selects how Words spells out a daytime.
+type WordStyle uint8
+
+const (
+	// Conversational reads the daytime as people say it on the 12-hour clock,
+	// naming the part of the day (e.g., "twenty past nine in the morning", "quarter to midnight").
+	Conversational WordStyle = iota
+
+	// Digital reads the hour and minutes off a 12-hour clock (e.g., "nine twenty", "nine oh five").
+	Digital
+
+	// Military reads the daytime on the 24-hour clock (e.g., "twenty-one hundred hours").
+	Military
+)
+
+// DayPart names a part of the day for Conversational readings.
+type DayPart struct {
+	// Name is the phrase appended to the reading, e.g. "in the morning".
+	Name string
+
+	// Range is the range of daytimes the part covers.
+	Range Range
+}
+
+// Words spells out the daytime in English in the given style.
+//
+// It is shorthand for English.Words; see Language.Words.
+func Words(d Daytime, style WordStyle) string {
+	return English.Words(d, style)
+}
+
+// Words spells out the daytime in the language in the given style.
+//
+// Seconds are dropped. StartOfDay and EndOfDay are told apart in every style:
+// "midnight" and "end of day" in the Conversational and Digital styles, and
+// "zero hundred hours" and "twenty-four hundred hours" in the Military style.
+// Conversational readings also name noon, and use midnight and noon as the hour
+// next to them (e.g., "ten past midnight", "quarter to noon").
+// Returns an empty string for invalid daytimes.
+func (l *Language) Words(d Daytime, style WordStyle) string {
+	if !d.Valid() {
+		return ""
+	}
+	hour, minute, _ := d.Clock()
+
+	if style == Military {
+		if minute == 0 {
+			return l.format(l.MilitaryHourFormat, l.militaryHour(hour), "")
+		}
+		return l.format(l.MilitaryFormat, l.militaryHour(hour), l.clockMinutes(minute))
+	}
+
+	switch d.Truncate(time.Minute) {
+	case StartOfDay:
+		return l.MidnightName
+	case EndOfDay:
+		return l.EndOfDayName
+	}
+
+	if style == Digital {
+		h := (hour+11)%12 + 1
+		if minute == 0 {
+			return l.format(l.OClockFormat, l.hour(h), "")
+		}
+		return l.format(l.ClockFormat, l.hour(h), l.clockMinutes(minute))
+	}
+
+	if hour == 12 && minute == 0 {
+		return l.NoonName
+	}
+	var phrase string
+	switch {
+	case minute == 0:
+		phrase = l.format(l.OClockFormat, l.hour(hour%12), "")
+	case minute <= 30:
+		phrase = l.format(l.PastFormat, l.namedHour(hour), l.minutes(minute, false))
+	default:
+		phrase = l.format(l.ToFormat, l.namedHour(hour+1), l.minutes(60-minute, true))
+	}
+	if (hour == 0 || hour == 12) && minute <= 30 || (hour == 11 || hour == 23) && minute > 30 {
+		return phrase
+	}
+	for _, part := range l.DayParts {
+		if part.Range.Contains(d) {
+			return phrase + " " + part.Name
+		}
+	}
+	return phrase
+}
+
+// --- Helper functions ---
+
+// format replaces "{hour}" and "{minutes}" in the layout.
+func (l *Language) format(layout, hour, minutes string) string {
+	return strings.NewReplacer("{hour}", hour, "{minutes}", minutes).Replace(layout)
+}
+
+// hour spells out an hour with Hour, falling back to Cardinal.
+func (l *Language) hour(h int) string {
+	if l.Hour != nil {
+		return l.Hour(h)
+	}
+	return l.Cardinal(h)
+}
+
+// namedHour spells out an hour on the 24-hour clock as an hour on the 12-hour clock,
+// naming midnight and noon.
+func (l *Language) namedHour(h int) string {
+	switch h % hoursInDay {
+	case 0:
+		return l.MidnightName
+	case 12:
+		return l.NoonName
+	default:
+		return l.hour(h % 12)
+	}
+}
+
+// minutes spells out conversational minutes with Minutes, falling back to Cardinal.
+func (l *Language) minutes(m int, to bool) string {
+	if l.Minutes != nil {
+		return l.Minutes(m, to)
+	}
+	return l.Cardinal(m)
+}
+
+// clockMinutes spells out clock minutes with ClockMinutes, falling back to Cardinal.
+func (l *Language) clockMinutes(m int) string {
+	if l.ClockMinutes != nil {
+		return l.ClockMinutes(m)
+	}
+	return l.Cardinal(m)
+}
+
+// militaryHour spells out a military hour with MilitaryHour, falling back to hour.
+func (l *Language) militaryHour(h int) string {
+	if l.MilitaryHour != nil {
+		return l.MilitaryHour(h)
+	}
+	return l.hour(h)
+}
+
+var (
+	englishOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	englishTens = []string{"", "", "twenty", "thirty", "forty", "fifty"}
+)
+
+// englishCardinal spells out a number from 0 to 59 in English.
+func englishCardinal(n int) string {
+	switch {
+	case n < 20:
+		return englishOnes[n]
+	case n%10 == 0:
+		return englishTens[n/10]
+	default:
+		return englishTens[n/10] + "-" + englishOnes[n%10]
+	}
+}
+
+// englishMinutes spells out conversational minutes in English.
+func englishMinutes(m int, _ bool) string {
+	switch {
+	case m == 15:
+		return "quarter"
+	case m == 30:
+		return "half"
+	case m%5 == 0:
+		return englishCardinal(m)
+	case m == 1:
+		return "one minute"
+	default:
+		return englishCardinal(m) + " minutes"
+	}
+}
+
+// englishClockMinutes spells out clock minutes in English, reading a leading zero as "oh".
+func englishClockMinutes(m int) string {
+	if m < 10 {
+		return "oh " + englishCardinal(m)
+	}
+	return englishCardinal(m)
+}
+
+// englishMilitaryHour spells out a military hour in English.
+func englishMilitaryHour(h int) string {
+	if h == 0 {
+		return "zero"
+	}
+	return englishClockMinutes(h)
+}
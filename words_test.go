@@ -0,0 +1,60 @@
+package daytime
+
+import "testing"
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		d     Daytime
+		style WordStyle
+		want  string
+	}{
+		{"Midnight", D000000, Conversational, "midnight"},
+		{"Midnight with seconds", Must(0, 0, 59), Conversational, "midnight"},
+		{"End of day", D240000, Conversational, "end of day"},
+		{"Noon", D120000, Conversational, "noon"},
+		{"Past midnight", Must(0, 5, 0), Conversational, "five past midnight"},
+		{"Past noon", Must(12, 15, 0), Conversational, "quarter past noon"},
+		{"To midnight", Must(23, 45, 0), Conversational, "quarter to midnight"},
+		{"To noon", Must(11, 35, 0), Conversational, "twenty-five to noon"},
+		{"Morning", Must(9, 20, 0), Conversational, "twenty past nine in the morning"},
+		{"Quarter to in the morning", Must(0, 45, 0), Conversational, "quarter to one in the morning"},
+		{"Afternoon", Must(13, 30, 0), Conversational, "half past one in the afternoon"},
+		{"Evening o'clock", Must(21, 0, 0), Conversational, "nine o'clock in the evening"},
+		{"One minute", Must(21, 1, 0), Conversational, "one minute past nine in the evening"},
+		{"Minutes", Must(21, 7, 30), Conversational, "seven minutes past nine in the evening"},
+		{"Digital", Must(9, 20, 0), Digital, "nine twenty"},
+		{"Digital afternoon", Must(21, 20, 0), Digital, "nine twenty"},
+		{"Digital leading zero", Must(9, 5, 0), Digital, "nine oh five"},
+		{"Digital o'clock", D120000, Digital, "twelve o'clock"},
+		{"Digital after midnight", Must(0, 30, 0), Digital, "twelve thirty"},
+		{"Digital midnight", D000000, Digital, "midnight"},
+		{"Digital end of day", D240000, Digital, "end of day"},
+		{"Military", D230000, Military, "twenty-three hundred hours"},
+		{"Military morning", Must(9, 5, 0), Military, "oh nine oh five hours"},
+		{"Military with minutes", Must(21, 45, 0), Military, "twenty-one forty-five hours"},
+		{"Military midnight", D000000, Military, "zero hundred hours"},
+		{"Military end of day", D240000, Military, "twenty-four hundred hours"},
+		{"Invalid", DInvalid, Conversational, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Words(tt.d, tt.style); got != tt.want {
+				t.Errorf("Words(%s, %d) got %q, want %q", tt.d, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWords_RoundTrip(t *testing.T) {
+	for _, lang := range []*Language{English, French} {
+		for d := StartOfDay; d <= EndOfDay; d += 60 {
+			words := lang.Words(d, Conversational)
+			got, err := lang.Parse(words)
+			if err != nil || got != d {
+				t.Errorf("Parse(Words(%s)) of %q got (%s, %v), want %s", d, words, got, err, d)
+			}
+		}
+	}
+}
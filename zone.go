@@ -0,0 +1,302 @@
+package daytime
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DSTPolicy selects how TimeIn resolves local times affected by daylight saving transitions.
+//
+// A nonexistent time falls into the gap skipped when clocks move forward,
+// and an ambiguous time occurs twice when clocks move back.
+type DSTPolicy uint8
+
+const (
+	// ShiftForward moves a nonexistent time forward by the length of the gap
+	// (02:30 becomes 03:30 when clocks jump from 02:00 to 03:00)
+	// and resolves an ambiguous time to its earliest instant.
+	ShiftForward DSTPolicy = iota
+
+	// Skip leaves a nonexistent time unresolved
+	// and resolves an ambiguous time to its earliest instant.
+	Skip
+
+	// Earliest resolves an ambiguous time to its earliest instant
+	// and a nonexistent time to the instant the gap ends (03:00 in the example above).
+	Earliest
+
+	// Latest resolves an ambiguous time to its latest instant
+	// and a nonexistent time to the instant the gap ends.
+	Latest
+)
+
+// Instants returns every instant at which the wall clock in loc shows the daytime on the date.
+//
+// The result is empty for a nonexistent time, has two instants in chronological order for an
+// ambiguous time, and has one instant otherwise. Only the calendar date of date is used;
+// EndOfDay means midnight at the start of the following date. If loc is nil, the location
+// of date is used.
+func (d Daytime) Instants(date time.Time, loc *time.Location) []time.Time {
+	w, loc := d.wallClock(date, loc)
+	return instantsOf(w, loc)
+}
+
+// TimeIn returns the instant at which the wall clock in loc shows the daytime on the date,
+// resolving daylight saving gaps and overlaps according to the policy.
+//
+// Returns false if the daytime is invalid or the policy leaves a nonexistent time unresolved.
+// Unlike Time, which silently normalizes such times, the resolution is explicit.
+// Only the calendar date of date is used; if loc is nil, the location of date is used.
+func (d Daytime) TimeIn(date time.Time, loc *time.Location, policy DSTPolicy) (time.Time, bool) {
+	if !d.Valid() {
+		return time.Time{}, false
+	}
+
+	w, loc := d.wallClock(date, loc)
+	instants := instantsOf(w, loc)
+	switch {
+	case len(instants) == 0:
+		return resolveGap(w, loc, policy)
+	case len(instants) > 1 && policy == Latest:
+		return instants[len(instants)-1], true
+	default:
+		return instants[0], true
+	}
+}
+
+// TimeInStrict returns the instant at which the wall clock in loc shows the daytime on the date.
+//
+// Returns ErrNonexistentTime if the time falls into a daylight saving gap,
+// ErrAmbiguousTime if it occurs twice, and ErrValueOutOfRange for invalid daytimes.
+// Only the calendar date of date is used; if loc is nil, the location of date is used.
+func (d Daytime) TimeInStrict(date time.Time, loc *time.Location) (time.Time, error) {
+	if !d.Valid() {
+		return time.Time{}, errorf("TimeInStrict", uint32(d), ErrValueOutOfRange)
+	}
+
+	w, loc := d.wallClock(date, loc)
+	instants := instantsOf(w, loc)
+	switch len(instants) {
+	case 0:
+		return time.Time{}, errorf("TimeInStrict", w.Format(time.DateTime)+" "+loc.String(), ErrNonexistentTime)
+	case 1:
+		return instants[0], nil
+	default:
+		return time.Time{}, errorf("TimeInStrict", w.Format(time.DateTime)+" "+loc.String(), ErrAmbiguousTime)
+	}
+}
+
+// occurrenceDays is the number of dates searched for the next or previous occurrence of a daytime:
+// the date of the given time, whose occurrence may lie on the wrong side of it, then the adjacent
+// date, which a daylight saving gap may skip, and the one after. A gap skips at most one day.
+const occurrenceDays = 3
+
+// Next returns the first instant after the given time at which the wall clock
+// in the location of after shows the daytime.
+//
+// Days on which the daytime falls into a daylight saving gap are skipped; when it occurs
+// twice, both instants are candidates. EndOfDay matches midnight at the start of each day.
+// Returns the zero time for invalid daytimes.
+func (d Daytime) Next(after time.Time) time.Time {
+	if !d.Valid() {
+		return time.Time{}
+	}
+
+	year, month, day := after.Date()
+	for i := range occurrenceDays {
+		date := time.Date(year, month, day+i, 0, 0, 0, 0, time.UTC)
+		for _, instant := range d.Instants(date, after.Location()) {
+			if instant.After(after) {
+				return instant
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// Prev returns the last instant before the given time at which the wall clock
+// in the location of before shows the daytime.
+//
+// It follows the same rules as Next.
+// Returns the zero time for invalid daytimes.
+func (d Daytime) Prev(before time.Time) time.Time {
+	if !d.Valid() {
+		return time.Time{}
+	}
+
+	year, month, day := before.Date()
+	for i := range occurrenceDays {
+		date := time.Date(year, month, day-i, 0, 0, 0, 0, time.UTC)
+		instants := d.Instants(date, before.Location())
+		for _, instant := range slices.Backward(instants) {
+			if instant.Before(before) {
+				return instant
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// DayRange is a range of daytimes on the day Offset days after a reference date.
+type DayRange struct {
+	// Offset is the number of days from the reference date, negative for earlier days.
+	Offset int
+
+	// Range is the range of daytimes on that day.
+	Range Range
+}
+
+// String returns the range followed by a signed day offset if it is not zero,
+// e.g. "22:00:00-24:00:00 -1d".
+func (r DayRange) String() string {
+	if r.Offset == 0 {
+		return r.Range.String()
+	}
+	return fmt.Sprintf("%s %+dd", r.Range, r.Offset)
+}
+
+// Convert converts the daytime on the date from one location to another.
+//
+// Returns the wall clock time in to at the instant the wall clock in from shows d,
+// and the number of days that day is away from the date, following the same
+// rules as Add: a result at midnight after the date is EndOfDay with zero days.
+// Nonexistent times are resolved with ShiftForward and ambiguous times with their
+// earliest instant. A nil location means the location of date.
+// Invalid daytimes are returned unchanged with zero days.
+func Convert(d Daytime, date time.Time, from, to *time.Location) (Daytime, int) {
+	if !d.Valid() {
+		return d, 0
+	}
+	instant, _ := d.TimeIn(date, from, ShiftForward)
+	return FromTimeOffset(referenceDate(date, to), instant)
+}
+
+// ConvertRange converts the range on the date from one location to another.
+//
+// A range spanning midnight ends on the day after the date. The converted range is
+// split at every midnight in to, and each piece is returned with its day offset from
+// the date in chronological order. Bounds are kept; pieces ending at midnight include
+// EndOfDay, like Split. Endpoints are resolved as in Convert, so the result reflects
+// daylight saving transitions on the date. An empty range, or one lying
+// entirely inside a daylight saving gap, yields nil.
+func ConvertRange(r Range, date time.Time, from, to *time.Location) []DayRange {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	endDate := date
+	if r.Wraps() {
+		endDate = date.AddDate(0, 0, 1)
+	}
+	startInstant, _ := r.start.TimeIn(date, from, ShiftForward)
+	endInstant, _ := r.end.TimeIn(endDate, from, ShiftForward)
+
+	ref := referenceDate(date, to)
+	start, startDay := FromTimeOffset(ref, startInstant)
+	end, endDay := FromTimeOffset(ref, endInstant)
+	if start == EndOfDay {
+		start, startDay = StartOfDay, startDay+1
+	}
+	if end == StartOfDay && endDay > startDay {
+		end, endDay = EndOfDay, endDay-1
+	}
+
+	if startDay == endDay {
+		// A range inside a daylight saving gap collapses to nothing.
+		converted := Range{start: start, end: end, bounds: r.bounds}
+		if converted.IsEmpty() {
+			return nil
+		}
+		return []DayRange{{Offset: startDay, Range: converted}}
+	}
+
+	pieces := []DayRange{{Offset: startDay, Range: Range{start: start, end: EndOfDay, bounds: boundsOf(r.IncludesStart(), true)}}}
+	for day := startDay + 1; day < endDay; day++ {
+		pieces = append(pieces, DayRange{Offset: day, Range: Range{start: StartOfDay, end: EndOfDay, bounds: Closed}})
+	}
+	last := Range{start: StartOfDay, end: end, bounds: boundsOf(true, r.IncludesEnd())}
+	if !last.IsEmpty() {
+		pieces = append(pieces, DayRange{Offset: endDay, Range: last})
+	}
+	return pieces
+}
+
+// --- Helper functions ---
+
+// referenceDate returns midnight of the calendar date of date in loc,
+// or in the location of date if loc is nil.
+func referenceDate(date time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = date.Location()
+	}
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// wallClock returns the wall clock reading of the daytime on the date as a UTC time,
+// with EndOfDay moved to midnight of the following date, together with the effective location.
+func (d Daytime) wallClock(date time.Time, loc *time.Location) (time.Time, *time.Location) {
+	if loc == nil {
+		loc = date.Location()
+	}
+	year, month, day := date.Date()
+	hour, minute, second := d.Clock()
+	// time.Date normalizes hour 24 to midnight of the following date.
+	return time.Date(year, month, day, hour, minute, second, 0, time.UTC), loc
+}
+
+// instantsOf returns the instants at which the wall clock in loc shows w (given in UTC).
+func instantsOf(w time.Time, loc *time.Location) []time.Time {
+	// Zone offsets a day before and after cover any single transition around w.
+	offsets := []int{
+		offsetAt(w.Add(-24*time.Hour), loc),
+		offsetAt(w, loc),
+		offsetAt(w.Add(24*time.Hour), loc),
+	}
+
+	var instants []time.Time
+	for _, offset := range offsets {
+		u := w.Add(-time.Duration(offset) * time.Second).In(loc)
+		if !sameWallClock(u, w) || slices.ContainsFunc(instants, u.Equal) {
+			continue
+		}
+		instants = append(instants, u)
+	}
+	slices.SortFunc(instants, time.Time.Compare)
+	return instants
+}
+
+// resolveGap resolves a nonexistent wall clock reading w (given in UTC) in loc.
+func resolveGap(w time.Time, loc *time.Location, policy DSTPolicy) (time.Time, bool) {
+	// Interpreting w with the offset in effect before the gap lands after the gap,
+	// shifted forward by its length.
+	before := offsetAt(w.Add(-24*time.Hour), loc)
+	shifted := w.Add(-time.Duration(before) * time.Second).In(loc)
+
+	switch policy {
+	case Skip:
+		return time.Time{}, false
+	case Earliest, Latest:
+		// The instant the gap ends starts the zone in effect at the shifted time.
+		start, _ := shifted.ZoneBounds()
+		return start, true
+	default:
+		return shifted, true
+	}
+}
+
+// offsetAt returns the zone offset of loc at instant t in seconds east of UTC.
+func offsetAt(t time.Time, loc *time.Location) int {
+	_, offset := t.In(loc).Zone()
+	return offset
+}
+
+// sameWallClock reports whether t shows the same date and clock as w (given in UTC).
+func sameWallClock(t, w time.Time) bool {
+	ty, tm, td := t.Date()
+	wy, wm, wd := w.Date()
+	th, tmin, ts := t.Clock()
+	wh, wmin, ws := w.Clock()
+	return ty == wy && tm == wm && td == wd && th == wh && tmin == wmin && ts == ws
+}
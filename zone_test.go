@@ -0,0 +1,318 @@
+package daytime
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q) failed: %v", name, err)
+	}
+	return loc
+}
+
+func TestDaytime_TimeIn(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	saoPaulo := mustLoadLocation(t, "America/Sao_Paulo")
+	springForward := time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC)
+	fallBack := time.Date(2025, time.October, 26, 0, 0, 0, 0, time.UTC)
+	regular := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	utc := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2025, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		d      Daytime
+		date   time.Time
+		loc    *time.Location
+		policy DSTPolicy
+		want   time.Time
+		ok     bool
+	}{
+		{"Regular time", D123045, regular, berlin, ShiftForward, time.Date(2025, time.June, 1, 10, 30, 45, 0, time.UTC), true},
+		{"Regular time ignores policy", D123045, regular, berlin, Latest, time.Date(2025, time.June, 1, 10, 30, 45, 0, time.UTC), true},
+		{"EndOfDay is next midnight", D240000, regular, berlin, ShiftForward, utc(time.June, 1, 22, 0), true},
+		{"Gap: ShiftForward", Must(2, 30, 0), springForward, berlin, ShiftForward, utc(time.March, 30, 1, 30), true},
+		{"Gap: Skip", Must(2, 30, 0), springForward, berlin, Skip, time.Time{}, false},
+		{"Gap: Earliest", Must(2, 30, 0), springForward, berlin, Earliest, utc(time.March, 30, 1, 0), true},
+		{"Gap: Latest", Must(2, 30, 0), springForward, berlin, Latest, utc(time.March, 30, 1, 0), true},
+		{"Gap edge is valid", Must(3, 0, 0), springForward, berlin, Skip, utc(time.March, 30, 1, 0), true},
+		{"Overlap: ShiftForward", Must(2, 30, 0), fallBack, berlin, ShiftForward, utc(time.October, 26, 0, 30), true},
+		{"Overlap: Skip", Must(2, 30, 0), fallBack, berlin, Skip, utc(time.October, 26, 0, 30), true},
+		{"Overlap: Earliest", Must(2, 30, 0), fallBack, berlin, Earliest, utc(time.October, 26, 0, 30), true},
+		{"Overlap: Latest", Must(2, 30, 0), fallBack, berlin, Latest, utc(time.October, 26, 1, 30), true},
+		{"Midnight gap at EndOfDay", D240000, time.Date(2018, time.November, 3, 0, 0, 0, 0, time.UTC), saoPaulo, ShiftForward, time.Date(2018, time.November, 4, 3, 0, 0, 0, time.UTC), true},
+		{"Midnight gap skipped", D000000, time.Date(2018, time.November, 4, 0, 0, 0, 0, time.UTC), saoPaulo, Skip, time.Time{}, false},
+		{"Nil location uses date location", D120000, time.Date(2025, time.June, 1, 23, 0, 0, 0, berlin), nil, ShiftForward, utc(time.June, 1, 10, 0), true},
+		{"Invalid daytime", DInvalid, regular, berlin, ShiftForward, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.d.TimeIn(tt.date, tt.loc, tt.policy)
+			if ok != tt.ok {
+				t.Fatalf("TimeIn() got ok %v, want %v", ok, tt.ok)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("TimeIn() got %v, want %v", got, tt.want.In(berlin))
+			}
+			if ok && got.Location() != tt.loc && tt.loc != nil {
+				t.Errorf("TimeIn() got location %v, want %v", got.Location(), tt.loc)
+			}
+		})
+	}
+}
+
+func TestDaytime_Instants(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name string
+		d    Daytime
+		date time.Time
+		want int
+	}{
+		{"Regular time", D120000, time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC), 1},
+		{"Gap", Must(2, 0, 0), time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC), 0},
+		{"Overlap", Must(2, 0, 0), time.Date(2025, time.October, 26, 0, 0, 0, 0, time.UTC), 2},
+		{"After overlap", Must(3, 0, 0), time.Date(2025, time.October, 26, 0, 0, 0, 0, time.UTC), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.Instants(tt.date, berlin)
+			if len(got) != tt.want {
+				t.Fatalf("Instants() got %v, want %d instants", got, tt.want)
+			}
+			for i, instant := range got {
+				if FromTime(instant) != tt.d {
+					t.Errorf("Instants()[%d] = %v shows %s, want %s", i, instant, FromTime(instant), tt.d)
+				}
+				if i > 0 && !got[i-1].Before(instant) {
+					t.Errorf("Instants() not in chronological order: %v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestDaytime_TimeInStrict(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name string
+		d    Daytime
+		date time.Time
+		err  error
+	}{
+		{"Regular time", D120000, time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC), nil},
+		{"Nonexistent", Must(2, 30, 0), time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC), ErrNonexistentTime},
+		{"Ambiguous", Must(2, 30, 0), time.Date(2025, time.October, 26, 0, 0, 0, 0, time.UTC), ErrAmbiguousTime},
+		{"Invalid daytime", DInvalid, time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC), ErrValueOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.TimeInStrict(tt.date, berlin)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("TimeInStrict() got error %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				var e *Error
+				if !errors.As(err, &e) || e.Operation() != "TimeInStrict" {
+					t.Errorf("TimeInStrict() error %v is not an *Error for TimeInStrict", err)
+				}
+				return
+			}
+			if FromTime(got) != tt.d {
+				t.Errorf("TimeInStrict() got %v, want wall clock %s", got, tt.d)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	newYork := mustLoadLocation(t, "America/New_York")
+	summer := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		d        Daytime
+		date     time.Time
+		from, to *time.Location
+		want     Daytime
+		days     int
+	}{
+		{"Same day", Must(9, 0, 0), summer, berlin, newYork, Must(3, 0, 0), 0},
+		{"Next day", Must(18, 0, 0), summer, berlin, tokyo, Must(1, 0, 0), 1},
+		{"Previous day", Must(1, 0, 0), summer, berlin, newYork, Must(19, 0, 0), -1},
+		{"Midnight after date is EndOfDay", Must(17, 0, 0), summer, berlin, tokyo, D240000, 0},
+		{"EndOfDay input", D240000, summer, berlin, time.UTC, Must(22, 0, 0), 0},
+		{"Same location", D123045, summer, berlin, berlin, D123045, 0},
+		{"Standard time in winter", Must(9, 0, 0), time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC), berlin, newYork, Must(3, 0, 0), 0},
+		{"Offsets differ between DST switches", Must(9, 0, 0), time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC), berlin, newYork, Must(4, 0, 0), 0},
+		{"Gap shifts forward", Must(2, 30, 0), time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC), berlin, time.UTC, Must(1, 30, 0), 0},
+		{"Ambiguous uses earliest", Must(2, 30, 0), time.Date(2025, time.October, 26, 0, 0, 0, 0, time.UTC), berlin, time.UTC, Must(0, 30, 0), 0},
+		{"Invalid daytime", DInvalid, summer, berlin, tokyo, DInvalid, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, days := Convert(tt.d, tt.date, tt.from, tt.to)
+			if got != tt.want || days != tt.days {
+				t.Errorf("Convert() got (%s, %d), want (%s, %d)", got, days, tt.want, tt.days)
+			}
+		})
+	}
+}
+
+func TestConvertRange(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	newYork := mustLoadLocation(t, "America/New_York")
+	summer := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		r        Range
+		date     time.Time
+		from, to *time.Location
+		want     []DayRange
+	}{
+		{
+			"Same day", MustRange(Must(9, 0, 0), D180000, Closed), summer, berlin, newYork,
+			[]DayRange{{0, MustRange(Must(3, 0, 0), D120000, Closed)}},
+		},
+		{
+			"Split at midnight", MustRange(Must(9, 0, 0), D180000, ClosedOpen), summer, berlin, tokyo,
+			[]DayRange{
+				{0, MustRange(Must(16, 0, 0), D240000, Closed)},
+				{1, MustRange(D000000, D010000, ClosedOpen)},
+			},
+		},
+		{
+			"Ends exactly at midnight", MustRange(Must(9, 0, 0), Must(17, 0, 0), OpenClosed), summer, berlin, tokyo,
+			[]DayRange{{0, MustRange(Must(16, 0, 0), D240000, OpenClosed)}},
+		},
+		{
+			"Half-open end at midnight", MustRange(Must(9, 0, 0), Must(17, 0, 0), ClosedOpen), summer, berlin, tokyo,
+			[]DayRange{{0, MustRange(Must(16, 0, 0), D240000, ClosedOpen)}},
+		},
+		{
+			"Wrapped range lands on one day", MustRange(Must(22, 0, 0), D010000, ClosedOpen), summer, berlin, tokyo,
+			[]DayRange{{1, MustRange(Must(5, 0, 0), Must(8, 0, 0), ClosedOpen)}},
+		},
+		{
+			"Moved to previous day", MustRange(D010000, Must(9, 0, 0), ClosedOpen), summer, berlin, newYork,
+			[]DayRange{
+				{-1, MustRange(Must(19, 0, 0), D240000, Closed)},
+				{0, MustRange(D000000, Must(3, 0, 0), ClosedOpen)},
+			},
+		},
+		{
+			"Gap shortens range", MustRange(Must(1, 0, 0), Must(4, 0, 0), ClosedOpen), time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC), berlin, time.UTC,
+			[]DayRange{{0, MustRange(D000000, Must(2, 0, 0), ClosedOpen)}},
+		},
+		{
+			"Range inside gap", MustRange(Must(2, 0, 0), Must(3, 0, 0), ClosedOpen), time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC), berlin, time.UTC,
+			nil,
+		},
+		{"Empty range", MustRange(D120000, D120000, ClosedOpen), summer, berlin, tokyo, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConvertRange(tt.r, tt.date, tt.from, tt.to)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ConvertRange() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayRange_String(t *testing.T) {
+	tests := []struct {
+		r    DayRange
+		want string
+	}{
+		{DayRange{0, MustRange(D010000, D120000, ClosedOpen)}, "01:00:00-12:00:00"},
+		{DayRange{1, MustRange(D010000, D120000, ClosedOpen)}, "01:00:00-12:00:00 +1d"},
+		{DayRange{-1, MustRange(D230000, D240000, Closed)}, "[23:00:00-24:00:00] -1d"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.String(); got != tt.want {
+			t.Errorf("DayRange.String() got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestDaytime_NextPrev(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	at := func(month time.Month, day, hour, minute, second int) time.Time {
+		return time.Date(2025, month, day, hour, minute, second, 0, berlin)
+	}
+	utc := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2025, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		d        Daytime
+		t        time.Time
+		wantNext time.Time
+		wantPrev time.Time
+	}{
+		{"Later today", D180000, at(time.June, 1, 12, 0, 0), at(time.June, 1, 18, 0, 0), at(time.May, 31, 18, 0, 0)},
+		{"Earlier today", D060000, at(time.June, 1, 12, 0, 0), at(time.June, 2, 6, 0, 0), at(time.June, 1, 6, 0, 0)},
+		{"Exact match is excluded", D120000, at(time.June, 1, 12, 0, 0), at(time.June, 2, 12, 0, 0), at(time.May, 31, 12, 0, 0)},
+		{"One second later", D120000, at(time.June, 1, 11, 59, 59), at(time.June, 1, 12, 0, 0), at(time.May, 31, 12, 0, 0)},
+		{"EndOfDay is next midnight", D240000, at(time.June, 1, 12, 0, 0), at(time.June, 2, 0, 0, 0), at(time.June, 1, 0, 0, 0)},
+		{"StartOfDay", D000000, at(time.June, 1, 12, 0, 0), at(time.June, 2, 0, 0, 0), at(time.June, 1, 0, 0, 0)},
+		{"Skips DST gap", Must(2, 30, 0), at(time.March, 30, 0, 0, 0), at(time.March, 31, 2, 30, 0), at(time.March, 29, 2, 30, 0)},
+		{"Skips DST gap backwards", Must(2, 30, 0), at(time.March, 30, 12, 0, 0), at(time.March, 31, 2, 30, 0), at(time.March, 29, 2, 30, 0)},
+		{"First of ambiguous pair", Must(2, 30, 0), utc(time.October, 26, 0, 0), utc(time.October, 26, 0, 30), at(time.October, 25, 2, 30, 0)},
+		{"Second of ambiguous pair", Must(2, 30, 0), utc(time.October, 26, 1, 0), utc(time.October, 26, 1, 30), utc(time.October, 26, 0, 30)},
+		{"After ambiguous pair", Must(2, 30, 0), utc(time.October, 26, 2, 0), at(time.October, 27, 2, 30, 0), utc(time.October, 26, 1, 30)},
+		{"Invalid daytime", DInvalid, at(time.June, 1, 12, 0, 0), time.Time{}, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from := tt.t.In(berlin)
+			if got := tt.d.Next(from); !got.Equal(tt.wantNext) {
+				t.Errorf("Next() got %v, want %v", got, tt.wantNext.In(berlin))
+			}
+			if got := tt.d.Prev(from); !got.Equal(tt.wantPrev) {
+				t.Errorf("Prev() got %v, want %v", got, tt.wantPrev.In(berlin))
+			}
+		})
+	}
+}
+
+func TestDaytime_NextPrev_SkippedDay(t *testing.T) {
+	// Samoa skipped 2011-12-30 entirely when moving across the date line.
+	apia := mustLoadLocation(t, "Pacific/Apia")
+	from := time.Date(2011, time.December, 29, 18, 0, 0, 0, apia)
+
+	got := D120000.Next(from)
+	want := time.Date(2011, time.December, 31, 12, 0, 0, 0, apia)
+	if !got.Equal(want) {
+		t.Errorf("Next() got %v, want %v", got, want)
+	}
+	if got.Location() != apia {
+		t.Errorf("Next() got location %v, want %v", got.Location(), apia)
+	}
+	if back := D120000.Prev(got); !back.Equal(time.Date(2011, time.December, 29, 12, 0, 0, 0, apia)) {
+		t.Errorf("Prev() got %v, want 2011-12-29 12:00", back)
+	}
+}